@@ -165,8 +165,16 @@ def analyze_demo_file(demo_path):
                 result = subprocess.run([go_binary, demo_path], stdout=outfile, stderr=subprocess.PIPE, text=True, encoding='utf-8')
             
             if result.returncode != 0:
-                print(f"Go parser error: {result.stderr}")
-                return f"Parser Error: {result.stderr}", None, "Unknown", 0, 0
+                # Non-zero exit: 1 = usage, 2 = open failure, 3 = parse failure.
+                # The error JSON is still written to stdout, prefer its message.
+                err_msg = result.stderr
+                try:
+                    with open(temp_out_file, "r", encoding="utf-8") as infile:
+                        err_msg = json.load(infile).get("error") or err_msg
+                except (OSError, ValueError):
+                    pass
+                print(f"Go parser error (exit {result.returncode}): {err_msg}")
+                return f"Parser Error: {err_msg}", None, "Unknown", 0, 0
                 
             # Parse JSON output from file
             try:
@@ -0,0 +1,86 @@
+package demostats
+
+import (
+	"io"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// LoggedEvent is one line of the -events log
+type LoggedEvent struct {
+	Type     string  `json:"type"` // round_start, round_end, kill, hurt, flashed, bomb_planted, bomb_defused, bomb_exploded
+	Tick     int     `json:"tick"`
+	Time     float64 `json:"time"` // Seconds since demo start
+	Round    int     `json:"round"`
+	Player   uint64  `json:"player,omitempty"`   // Victim / flashed player / bomb player
+	Attacker uint64  `json:"attacker,omitempty"` // Killer / attacker / flash thrower
+	Assister uint64  `json:"assister,omitempty"`
+	Weapon   string  `json:"weapon,omitempty"`
+	Damage   int     `json:"damage,omitempty"`
+	Headshot bool    `json:"headshot,omitempty"`
+	Winner   int     `json:"winner,omitempty"` // TeamNum, round_end only
+}
+
+// Events calls onEvent with each key event of the demo in r, in order, for
+// a chronological log instead of aggregated stats. Warmup is left out.
+func Events(r io.Reader, onEvent func(LoggedEvent)) error {
+	p, err := newParser(r)
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	round := 0
+	emit := func(ev LoggedEvent) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		ev.Tick = p.GameState().IngameTick()
+		ev.Time = p.CurrentTime().Seconds()
+		ev.Round = round
+		onEvent(ev)
+	}
+	steamID := func(pl *common.Player) uint64 {
+		if pl == nil {
+			return 0
+		}
+		return pl.SteamID64
+	}
+	weapon := func(eq *common.Equipment) string {
+		if eq == nil {
+			return ""
+		}
+		return weaponName(eq)
+	}
+
+	p.RegisterEventHandler(func(e events.RoundStart) {
+		if p.GameState().IsMatchStarted() {
+			round++
+		}
+		emit(LoggedEvent{Type: "round_start"})
+	})
+	p.RegisterEventHandler(func(e events.RoundEnd) {
+		emit(LoggedEvent{Type: "round_end", Winner: int(e.Winner)})
+	})
+	p.RegisterEventHandler(func(e events.Kill) {
+		emit(LoggedEvent{Type: "kill", Player: steamID(e.Victim), Attacker: steamID(e.Killer), Assister: steamID(e.Assister), Weapon: weapon(e.Weapon), Headshot: e.IsHeadshot})
+	})
+	p.RegisterEventHandler(func(e events.PlayerHurt) {
+		emit(LoggedEvent{Type: "hurt", Player: steamID(e.Player), Attacker: steamID(e.Attacker), Weapon: weapon(e.Weapon), Damage: e.HealthDamage})
+	})
+	p.RegisterEventHandler(func(e events.PlayerFlashed) {
+		emit(LoggedEvent{Type: "flashed", Player: steamID(e.Player), Attacker: steamID(e.Attacker)})
+	})
+	p.RegisterEventHandler(func(e events.BombPlanted) {
+		emit(LoggedEvent{Type: "bomb_planted", Player: steamID(e.Player)})
+	})
+	p.RegisterEventHandler(func(e events.BombDefused) {
+		emit(LoggedEvent{Type: "bomb_defused", Player: steamID(e.Player)})
+	})
+	p.RegisterEventHandler(func(e events.BombExplode) {
+		emit(LoggedEvent{Type: "bomb_exploded", Player: steamID(e.Player)})
+	})
+
+	return parseToEnd(p)
+}
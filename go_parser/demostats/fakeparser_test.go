@@ -0,0 +1,301 @@
+package demostats
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/r3"
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/sendtables"
+	dp "github.com/markus-wa/godispatch"
+)
+
+// fakeDemo is a scripted demoinfocs.Parser for driving parseMatch without
+// a demo file. Each frame is a func that dispatches events through the
+// handlers parseMatch registered. Anything parseMatch uses that isn't
+// faked here panics on the nil embedded interface.
+type fakeDemo struct {
+	demoinfocs.Parser
+	handlers []reflect.Value
+	frames   []func()
+	now      time.Duration
+	tick     int
+	started  bool
+	players  map[int]*common.Player // By user ID, so Playing() comes back in random order like the real thing
+	coaches  map[uint64]bool        // On a team but never spawn
+	teams    map[common.Team]*common.TeamState
+}
+
+const fakeTickRate = 64
+
+func newFakeDemo() *fakeDemo {
+	d := &fakeDemo{players: make(map[int]*common.Player), coaches: make(map[uint64]bool), teams: make(map[common.Team]*common.TeamState)}
+	for _, team := range []common.Team{common.TeamTerrorists, common.TeamCounterTerrorists} {
+		ts := common.NewTeamState(team, d.members, d)
+		ts.Entity = newFakeEntity()
+		d.teams[team] = &ts
+	}
+	return d
+}
+
+// addPlayer joins a connected, alive player to team
+func (d *fakeDemo) addPlayer(steamID uint64, name string, team common.Team) *common.Player {
+	pl := common.NewPlayer(d)
+	pl.SteamID64 = steamID
+	pl.Name = name
+	pl.Team = team
+	pl.IsConnected = true
+	pl.UserID = len(d.players) + 1
+	pl.EntityID = pl.UserID
+	pl.Entity = newFakeEntity()
+	setAlive(pl, true)
+	d.players[pl.UserID] = pl
+	return pl
+}
+
+// addCoach joins a coach to team: listed with the players, never alive
+func (d *fakeDemo) addCoach(steamID uint64, name string, team common.Team) *common.Player {
+	pl := d.addPlayer(steamID, name, team)
+	setAlive(pl, false)
+	d.coaches[steamID] = true
+	return pl
+}
+
+func setAlive(pl *common.Player, alive bool) {
+	e := pl.Entity.(*fakeEntity)
+	if alive {
+		e.props["m_iHealth"] = 100
+		e.props["m_lifeState"] = 0
+	} else {
+		e.props["m_iHealth"] = 0
+		e.props["m_lifeState"] = 1
+	}
+}
+
+// frame queues events to be dispatched by one ParseNextFrame call
+func (d *fakeDemo) frame(f func()) { d.frames = append(d.frames, f) }
+
+func (d *fakeDemo) dispatch(e any) {
+	ev := reflect.ValueOf(e)
+	for _, h := range d.handlers {
+		if ev.Type().AssignableTo(h.Type().In(0)) {
+			h.Call([]reflect.Value{ev})
+		}
+	}
+}
+
+// wait moves the demo clock forward
+func (d *fakeDemo) wait(dt time.Duration) {
+	d.now += dt
+	d.tick += int(dt.Seconds() * fakeTickRate)
+}
+
+// startMatch makes the match live, everything before it is warmup
+func (d *fakeDemo) startMatch() {
+	d.frame(func() {
+		d.started = true
+		d.dispatch(events.MatchStart{})
+	})
+}
+
+// round plays one round: everyone on a team respawns, freezetime ends,
+// play runs, and winner takes the round
+func (d *fakeDemo) round(winner common.Team, play func()) {
+	d.frame(func() {
+		for _, pl := range d.players {
+			if (pl.Team == common.TeamTerrorists || pl.Team == common.TeamCounterTerrorists) && !d.coaches[pl.SteamID64] {
+				setAlive(pl, true)
+			}
+		}
+		d.dispatch(events.RoundStart{})
+		d.wait(15 * time.Second)
+		d.dispatch(events.RoundFreezetimeEnd{})
+		play()
+		d.wait(time.Second)
+		loser := common.TeamCounterTerrorists
+		if winner == common.TeamCounterTerrorists {
+			loser = common.TeamTerrorists
+		}
+		score := d.teams[winner].Entity.(*fakeEntity)
+		score.props["m_scoreTotal"]++
+		d.dispatch(events.RoundEnd{Winner: winner, WinnerState: d.teams[winner], LoserState: d.teams[loser]})
+	})
+}
+
+// kill dispatches a kill and marks the victim dead. A zero weapon type
+// means no weapon at all.
+func (d *fakeDemo) kill(killer, victim *common.Player, weapon common.EquipmentType) {
+	d.tick++
+	e := events.Kill{Killer: killer, Victim: victim}
+	if weapon != common.EqUnknown {
+		e.Weapon = common.NewEquipment(weapon)
+	}
+	d.dispatch(e)
+	setAlive(victim, false)
+}
+
+// hurt dispatches damage from attacker (nil for the world) to victim
+func (d *fakeDemo) hurt(attacker, victim *common.Player, weapon common.EquipmentType, damage int) {
+	d.tick++
+	e := events.PlayerHurt{Attacker: attacker, Player: victim, HealthDamage: damage}
+	if weapon != common.EqUnknown {
+		e.Weapon = common.NewEquipment(weapon)
+	}
+	d.dispatch(e)
+}
+
+// plant dispatches planter planting the bomb at A
+func (d *fakeDemo) plant(planter *common.Player) {
+	d.dispatch(events.BombPlanted{BombEvent: events.BombEvent{Player: planter, Site: events.BombsiteA}})
+}
+
+func (d *fakeDemo) members(team common.Team) []*common.Player {
+	var res []*common.Player
+	for _, pl := range d.players {
+		if pl.Team == team {
+			res = append(res, pl)
+		}
+	}
+	return res
+}
+
+// demoinfocs.Parser
+
+func (d *fakeDemo) RegisterEventHandler(handler any) dp.HandlerIdentifier {
+	d.handlers = append(d.handlers, reflect.ValueOf(handler))
+	return nil
+}
+
+func (d *fakeDemo) ParseNextFrame() (bool, error) {
+	if len(d.frames) == 0 {
+		return false, nil
+	}
+	f := d.frames[0]
+	d.frames = d.frames[1:]
+	f()
+	return len(d.frames) > 0, nil
+}
+
+func (d *fakeDemo) CurrentTime() time.Duration              { return d.now }
+func (d *fakeDemo) Header() common.DemoHeader               { return common.DemoHeader{MapName: "de_test"} }
+func (d *fakeDemo) ParseHeader() (common.DemoHeader, error) { return d.Header(), nil }
+func (d *fakeDemo) GameState() demoinfocs.GameState         { return fakeGameState{d: d} }
+
+// common.demoInfoProvider, for the players and teams
+
+func (d *fakeDemo) IngameTick() int                              { return d.tick }
+func (d *fakeDemo) TickRate() float64                            { return fakeTickRate }
+func (d *fakeDemo) FindPlayerByHandle(uint64) *common.Player     { return nil }
+func (d *fakeDemo) FindPlayerByPawnHandle(uint64) *common.Player { return nil }
+func (d *fakeDemo) PlayerResourceEntity() st.Entity              { return nil }
+func (d *fakeDemo) FindWeaponByEntityID(int) *common.Equipment   { return nil }
+func (d *fakeDemo) FindEntityByHandle(uint64) st.Entity          { return nil }
+func (d *fakeDemo) IsSource2() bool                              { return false }
+
+type fakeGameState struct {
+	demoinfocs.GameState
+	d *fakeDemo
+}
+
+func (gs fakeGameState) IsMatchStarted() bool                    { return gs.d.started }
+func (gs fakeGameState) IngameTick() int                         { return gs.d.tick }
+func (gs fakeGameState) Team(team common.Team) *common.TeamState { return gs.d.teams[team] }
+func (gs fakeGameState) Participants() demoinfocs.Participants   { return fakeParticipants{d: gs.d} }
+func (gs fakeGameState) Rules() demoinfocs.GameRules             { return fakeRules{} }
+
+type fakeParticipants struct {
+	demoinfocs.Participants
+	d *fakeDemo
+}
+
+func (fp fakeParticipants) All() []*common.Player {
+	var res []*common.Player
+	for _, pl := range fp.d.players {
+		res = append(res, pl)
+	}
+	return res
+}
+
+func (fp fakeParticipants) Connected() []*common.Player {
+	var res []*common.Player
+	for _, pl := range fp.d.players {
+		if pl.IsConnected {
+			res = append(res, pl)
+		}
+	}
+	return res
+}
+
+func (fp fakeParticipants) Playing() []*common.Player {
+	var res []*common.Player
+	for _, pl := range fp.d.players {
+		if pl.Team != common.TeamSpectators && pl.Team != common.TeamUnassigned {
+			res = append(res, pl)
+		}
+	}
+	return res
+}
+
+type fakeRules struct {
+	demoinfocs.GameRules
+}
+
+func (fakeRules) RoundTime() (time.Duration, error)  { return defaultRoundTime, nil }
+func (fakeRules) FreezeTime() (time.Duration, error) { return 15 * time.Second, nil }
+func (fakeRules) BombTime() (time.Duration, error)   { return defaultBombTime, nil }
+func (fakeRules) ConVars() map[string]string         { return map[string]string{} }
+
+// fakeEntity holds a few int props; missing ones read as 0
+type fakeEntity struct {
+	st.Entity
+	props map[string]int
+	pos   r3.Vector
+}
+
+func newFakeEntity() *fakeEntity { return &fakeEntity{props: make(map[string]int)} }
+
+func (e *fakeEntity) PropertyValueMust(name string) st.PropertyValue {
+	return st.PropertyValue{IntVal: e.props[name]}
+}
+
+func (e *fakeEntity) PropertyValue(name string) (st.PropertyValue, bool) {
+	v, ok := e.props[name]
+	return st.PropertyValue{IntVal: v}, ok
+}
+
+func (e *fakeEntity) Property(name string) st.Property {
+	return fakeProperty{v: e.PropertyValueMust(name)}
+}
+func (e *fakeEntity) Position() r3.Vector { return e.pos }
+
+type fakeProperty struct {
+	st.Property
+	v st.PropertyValue
+}
+
+func (p fakeProperty) Value() st.PropertyValue { return p.v }
+
+// parse runs parseMatch over d, failing the test on an error
+func (d *fakeDemo) parse(t *testing.T, opts Options) MatchResult {
+	t.Helper()
+	result, err := parseMatch(d, opts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+// statsOf returns the row for steamID, failing the test if there is none
+func statsOf(t *testing.T, result MatchResult, steamID uint64) PlayerStats {
+	t.Helper()
+	for _, s := range result.Stats {
+		if s.SteamID == steamID {
+			return s
+		}
+	}
+	t.Fatalf("no stats for %d in %+v", steamID, result.Stats)
+	return PlayerStats{}
+}
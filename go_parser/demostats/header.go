@@ -0,0 +1,93 @@
+package demostats
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// HeaderResult is the -header output for one demo, straight from its header
+type HeaderResult struct {
+	File            string  `json:"file"`                // Set by the caller
+	Filestamp       string  `json:"filestamp,omitempty"` // HL2DEMO (CS:GO) or PBDEMS2 (CS2)
+	Protocol        int     `json:"protocol"`
+	NetworkProtocol int     `json:"network_protocol"`
+	ServerName      string  `json:"server_name"`
+	ClientName      string  `json:"client_name"`
+	MapName         string  `json:"map_name"`
+	GameDirectory   string  `json:"game_directory"`
+	PlaybackTime    float64 `json:"playback_time"` // Seconds
+	PlaybackTicks   int     `json:"playback_ticks"`
+	PlaybackFrames  int     `json:"playback_frames"`
+	TickRate        float64 `json:"tick_rate"` // Ticks per second, 0 if the header doesn't say
+	FrameRate       float64 `json:"frame_rate"`
+	SignonLength    int     `json:"signon_length"`
+	Error           string  `json:"error,omitempty"` // Set by the caller
+}
+
+// ValidationResult is the -validate output for one demo
+type ValidationResult struct {
+	File   string `json:"file"` // Set by the caller
+	Valid  bool   `json:"valid"`
+	Map    string `json:"map,omitempty"`
+	Rounds int    `json:"rounds"`
+	Error  string `json:"error,omitempty"` // Set by the caller
+}
+
+// Validate parses a demo from r with only a round counter attached, to
+// check it's a readable GOTV demo. On error the result has the rounds
+// read before it.
+func Validate(r io.Reader) (ValidationResult, error) {
+	var result ValidationResult
+	p, err := newParser(r)
+	if err != nil {
+		return result, err
+	}
+	defer p.Close()
+
+	p.RegisterEventHandler(func(e events.RoundEnd) {
+		if p.GameState().IsMatchStarted() {
+			result.Rounds++
+		}
+	})
+	if err := parseToEnd(p); err != nil {
+		return result, err
+	}
+	result.Valid = true
+	result.Map = displayMapName(p.Header().MapName)
+	return result, nil
+}
+
+// ReadHeader parses only the header of the demo in r
+func ReadHeader(r io.Reader) (HeaderResult, error) {
+	var result HeaderResult
+	p, err := newParser(r)
+	if err != nil {
+		return result, err
+	}
+	defer p.Close()
+
+	h, err := parseHeader(p)
+	if err != nil {
+		return result, fmt.Errorf("header: %w", err)
+	}
+	result.Filestamp = h.Filestamp
+	result.Protocol = h.Protocol
+	result.NetworkProtocol = h.NetworkProtocol
+	result.ServerName = h.ServerName
+	result.ClientName = h.ClientName
+	result.MapName = h.MapName
+	result.GameDirectory = h.GameDirectory
+	// The header stores floats, round away the float32 noise
+	result.PlaybackTime = math.Round(h.PlaybackTime.Seconds()*100) / 100
+	result.PlaybackTicks = h.PlaybackTicks
+	result.PlaybackFrames = h.PlaybackFrames
+	if h.PlaybackTime > 0 {
+		result.TickRate = math.Round(float64(h.PlaybackTicks) / h.PlaybackTime.Seconds())
+	}
+	result.FrameRate = math.Round(h.FrameRate()*100) / 100
+	result.SignonLength = h.SignonLength
+	return result, nil
+}
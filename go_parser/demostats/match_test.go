@@ -0,0 +1,517 @@
+package demostats
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// Burning to death in your own molotov is a death, not a kill, and the
+// fire doesn't count as damage dealt
+func TestMolotovSuicide(t *testing.T) {
+	d := newFakeDemo()
+	tPlayer := d.addPlayer(1, "t", common.TeamTerrorists)
+	d.addPlayer(2, "ct", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamCounterTerrorists, func() {
+		d.hurt(tPlayer, tPlayer, common.EqMolotov, 60)
+		d.hurt(tPlayer, tPlayer, common.EqMolotov, 40)
+		d.kill(tPlayer, tPlayer, common.EqMolotov)
+	})
+
+	s := statsOf(t, d.parse(t, DefaultOptions()), 1)
+	if s.Kills != 0 || s.TeamKills != 0 || s.Deaths != 1 {
+		t.Errorf("Kills, TeamKills, Deaths = %d, %d, %d, want 0, 0, 1", s.Kills, s.TeamKills, s.Deaths)
+	}
+	if s.Damage != 0 || s.FireDamage != 0 || s.SelfDamage != 100 {
+		t.Errorf("Damage, FireDamage, SelfDamage = %d, %d, %d, want 0, 0, 100", s.Damage, s.FireDamage, s.SelfDamage)
+	}
+	if len(s.WeaponKills) != 0 || s.EntryKills != 0 {
+		t.Errorf("WeaponKills = %v, EntryKills = %d, want none", s.WeaponKills, s.EntryKills)
+	}
+}
+
+// Fall damage has no attacker: the death counts, nobody gets a kill
+func TestFallDamageDeath(t *testing.T) {
+	d := newFakeDemo()
+	d.addPlayer(1, "t", common.TeamTerrorists)
+	ct := d.addPlayer(2, "ct", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.hurt(nil, ct, common.EqWorld, 100)
+		d.kill(nil, ct, common.EqWorld)
+	})
+
+	result := d.parse(t, DefaultOptions())
+	s := statsOf(t, result, 2)
+	if s.Deaths != 1 || s.Kills != 0 {
+		t.Errorf("Deaths, Kills = %d, %d, want 1, 0", s.Deaths, s.Kills)
+	}
+	if s.SelfDamage != 100 || s.DamageTaken != 100 {
+		t.Errorf("SelfDamage, DamageTaken = %d, %d, want 100, 100", s.SelfDamage, s.DamageTaken)
+	}
+	if other := statsOf(t, result, 1); other.Kills != 0 || other.Damage != 0 {
+		t.Errorf("the other player got Kills %d, Damage %d for a fall", other.Kills, other.Damage)
+	}
+}
+
+// A team kill on top of two frags is still a 2k round, and counts as a
+// team kill only: no kill, headshot or weapon stat
+func TestTeamKillIsNotAMultiKill(t *testing.T) {
+	d := newFakeDemo()
+	killer := d.addPlayer(1, "t1", common.TeamTerrorists)
+	mate := d.addPlayer(2, "t2", common.TeamTerrorists)
+	ct1 := d.addPlayer(3, "ct1", common.TeamCounterTerrorists)
+	ct2 := d.addPlayer(4, "ct2", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.kill(killer, ct1, common.EqAK47)
+		d.dispatch(events.Kill{Killer: killer, Victim: mate, Weapon: common.NewEquipment(common.EqAK47), IsHeadshot: true})
+		setAlive(mate, false)
+		d.kill(killer, ct2, common.EqAK47)
+	})
+
+	s := statsOf(t, d.parse(t, DefaultOptions()), 1)
+	if s.TeamKills != 1 {
+		t.Errorf("TeamKills = %d, want 1", s.TeamKills)
+	}
+	if s.MultiKills[2] != 1 || s.MultiKills[3] != 0 {
+		t.Errorf("MultiKills = %v, want one 2k and no 3k", s.MultiKills)
+	}
+	if len(s.MultiKillRounds) != 1 || s.MultiKillRounds[0].Kills != 2 {
+		t.Errorf("MultiKillRounds = %+v, want one round with 2 kills", s.MultiKillRounds)
+	}
+	if s.Kills != 2 || s.KD != 2 || s.Headshots != 0 {
+		t.Errorf("Kills, K/D, Headshots = %d, %v, %d, want 2, 2, 0", s.Kills, s.KD, s.Headshots)
+	}
+	if s.WeaponKills["AK-47"] != 2 || s.WeaponStats["AK-47"].Kills != 2 || s.KillsByCategory["rifle"] != 2 {
+		t.Errorf("WeaponKills, WeaponStats, KillsByCategory = %v, %+v, %v, want 2 AK-47 rifle kills", s.WeaponKills, s.WeaponStats, s.KillsByCategory)
+	}
+}
+
+// A coach sits on a team in Participants but never plays: no scoreboard
+// row, and not counted as one of the 10 players
+func TestCoachIsNotAPlayer(t *testing.T) {
+	d := newFakeDemo()
+	var ts, cts []*common.Player
+	for i := uint64(1); i <= 5; i++ {
+		ts = append(ts, d.addPlayer(i, "t", common.TeamTerrorists))
+		cts = append(cts, d.addPlayer(10+i, "ct", common.TeamCounterTerrorists))
+	}
+	d.addCoach(99, "coach", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		for _, ct := range cts {
+			d.kill(ts[0], ct, common.EqAK47)
+		}
+	})
+
+	result := d.parse(t, DefaultOptions())
+	if result.PlayerCount != 10 {
+		t.Errorf("PlayerCount = %d, want 10", result.PlayerCount)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Warnings = %q, want none", result.Warnings)
+	}
+	if len(result.Stats) != 10 {
+		t.Errorf("got %d stats rows, want 10", len(result.Stats))
+	}
+	for _, s := range result.Stats {
+		if s.SteamID == 99 {
+			t.Errorf("the coach has a stats row: %+v", s)
+		}
+	}
+}
+
+// Friendly fire is kept in TeamDamage and stays out of Damage and ADR
+func TestTeamDamageDoesNotInflateADR(t *testing.T) {
+	d := newFakeDemo()
+	shooter := d.addPlayer(1, "t1", common.TeamTerrorists)
+	mate := d.addPlayer(2, "t2", common.TeamTerrorists)
+	ct := d.addPlayer(3, "ct", common.TeamCounterTerrorists)
+	d.startMatch()
+	for i := 0; i < 2; i++ {
+		d.round(common.TeamCounterTerrorists, func() {
+			d.hurt(shooter, mate, common.EqHE, 90)
+			d.hurt(shooter, mate, common.EqAK47, 60)
+			d.hurt(shooter, ct, common.EqAK47, 50)
+		})
+	}
+
+	s := statsOf(t, d.parse(t, DefaultOptions()), 1)
+	if s.TeamDamage != 300 || s.Damage != 100 {
+		t.Errorf("TeamDamage, Damage = %d, %d, want 300, 100", s.TeamDamage, s.Damage)
+	}
+	if s.ADR != 50 {
+		t.Errorf("ADR = %v, want 50", s.ADR)
+	}
+	if s.UtilityDamage != 0 {
+		t.Errorf("UtilityDamage = %d, want 0, the HE only hit a teammate", s.UtilityDamage)
+	}
+}
+
+// Parsing the same demo again gives byte-identical output, whatever order
+// the maps inside the parser and Playing() come back in
+func TestParseIsDeterministic(t *testing.T) {
+	demo := func() *fakeDemo {
+		d := newFakeDemo()
+		var ts, cts []*common.Player
+		for i := uint64(1); i <= 5; i++ {
+			ts = append(ts, d.addPlayer(i, "t", common.TeamTerrorists))
+			cts = append(cts, d.addPlayer(10+i, "ct", common.TeamCounterTerrorists))
+		}
+		d.startMatch()
+		d.round(common.TeamTerrorists, func() {
+			d.hurt(ts[0], cts[0], common.EqAK47, 100)
+			d.kill(ts[0], cts[0], common.EqAK47)
+			d.kill(ts[1], cts[1], common.EqDeagle)
+			d.kill(cts[2], ts[2], common.EqM4A1)
+		})
+		d.round(common.TeamCounterTerrorists, func() {
+			d.kill(cts[3], ts[3], common.EqAWP)
+			d.kill(cts[4], ts[4], common.EqM4A4)
+		})
+		return d
+	}
+	opts := DefaultOptions()
+	opts.Rounds = true
+	opts.Killfeed = true
+
+	first := demo().parse(t, opts)
+	wantJSON, err := json.Marshal(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		result := demo().parse(t, opts)
+		gotJSON, _ := json.Marshal(result)
+		if string(gotJSON) != string(wantJSON) {
+			t.Fatalf("run %d gave different JSON:\n%s\nwant:\n%s", i+2, gotJSON, wantJSON)
+		}
+	}
+}
+
+// A demo that never goes live parses fine but says so, instead of looking
+// like a real 0-0 match
+func TestWarmupOnlyDemo(t *testing.T) {
+	d := newFakeDemo()
+	tPlayer := d.addPlayer(1, "t", common.TeamTerrorists)
+	ct := d.addPlayer(2, "ct", common.TeamCounterTerrorists)
+	d.frame(func() {
+		d.hurt(tPlayer, ct, common.EqAK47, 100)
+		d.kill(tPlayer, ct, common.EqAK47)
+	})
+
+	result := d.parse(t, DefaultOptions())
+	if result.Error != "" {
+		t.Errorf("Error = %q, want none", result.Error)
+	}
+	if len(result.Stats) != 0 {
+		t.Errorf("got stats for warmup: %+v", result.Stats)
+	}
+	want := "no live match detected, the demo only contains warmup or was aborted"
+	if len(result.Warnings) != 1 || result.Warnings[0] != want {
+		t.Errorf("Warnings = %q, want [%q]", result.Warnings, want)
+	}
+}
+
+// The match can go live mid-round, with the first kills before any
+// RoundStart. The first of them is still the round's only opening duel.
+func TestFirstRoundOpeningDuel(t *testing.T) {
+	d := newFakeDemo()
+	t1 := d.addPlayer(1, "t1", common.TeamTerrorists)
+	t2 := d.addPlayer(2, "t2", common.TeamTerrorists)
+	ct1 := d.addPlayer(3, "ct1", common.TeamCounterTerrorists)
+	ct2 := d.addPlayer(4, "ct2", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.frame(func() {
+		d.wait(20 * time.Second)
+		d.kill(ct1, t1, common.EqM4A4)
+		d.kill(t2, ct1, common.EqAK47)
+		d.kill(t2, ct2, common.EqAK47)
+		d.dispatch(events.RoundEnd{Winner: common.TeamTerrorists, WinnerState: d.teams[common.TeamTerrorists], LoserState: d.teams[common.TeamCounterTerrorists]})
+	})
+	d.round(common.TeamCounterTerrorists, func() {
+		d.kill(ct2, t2, common.EqM4A4)
+	})
+
+	result := d.parse(t, DefaultOptions())
+	want := []OpeningDuel{{Round: 1, Winner: 3, Loser: 1}, {Round: 2, Winner: 4, Loser: 2}}
+	if !reflect.DeepEqual(result.OpeningDuels, want) {
+		t.Errorf("OpeningDuels = %+v, want %+v", result.OpeningDuels, want)
+	}
+	if s := statsOf(t, result, 3); s.EntryKills != 1 {
+		t.Errorf("first round opener: EntryKills = %d, want 1", s.EntryKills)
+	}
+	if s := statsOf(t, result, 2); s.EntryKills != 0 || s.EntryDeaths != 1 {
+		t.Errorf("later first round killer: EntryKills, EntryDeaths = %d, %d, want 0, 1", s.EntryKills, s.EntryDeaths)
+	}
+	if s := statsOf(t, result, 1); s.EntryDeaths != 1 {
+		t.Errorf("first round victim: EntryDeaths = %d, want 1", s.EntryDeaths)
+	}
+}
+
+// A kill without a weapon still counts, as a "world" kill, and a death
+// nobody caused is just a death
+func TestWorldKills(t *testing.T) {
+	d := newFakeDemo()
+	tPlayer := d.addPlayer(1, "t", common.TeamTerrorists)
+	ct1 := d.addPlayer(2, "ct1", common.TeamCounterTerrorists)
+	ct2 := d.addPlayer(3, "ct2", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.kill(tPlayer, ct1, common.EqUnknown)
+		d.kill(nil, ct2, common.EqUnknown)
+	})
+	opts := DefaultOptions()
+	opts.Killfeed = true
+
+	result := d.parse(t, opts)
+	s := statsOf(t, result, 1)
+	if s.Kills != 1 || s.WeaponKills[worldWeapon] != 1 {
+		t.Errorf("Kills = %d, WeaponKills = %v, want 1 %s kill", s.Kills, s.WeaponKills, worldWeapon)
+	}
+	for _, id := range []uint64{2, 3} {
+		if s := statsOf(t, result, id); s.Deaths != 1 {
+			t.Errorf("%d: Deaths = %d, want 1", id, s.Deaths)
+		}
+	}
+	if len(result.Killfeed) != 2 || result.Killfeed[0].Weapon != worldWeapon || result.Killfeed[1].Killer != 0 {
+		t.Errorf("Killfeed = %+v, want two world kills, the second without a killer", result.Killfeed)
+	}
+}
+
+// The C4 killing a CT is the planter's BombKill, not a normal kill
+func TestBombKill(t *testing.T) {
+	d := newFakeDemo()
+	planter := d.addPlayer(1, "t", common.TeamTerrorists)
+	ct := d.addPlayer(2, "ct", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.plant(planter)
+		d.wait(defaultBombTime)
+		d.kill(nil, ct, common.EqBomb)
+	})
+
+	result := d.parse(t, DefaultOptions())
+	s := statsOf(t, result, 1)
+	if s.BombKills != 1 || s.Kills != 0 || len(s.WeaponKills) != 0 {
+		t.Errorf("BombKills, Kills, WeaponKills = %d, %d, %v, want 1, 0, none", s.BombKills, s.Kills, s.WeaponKills)
+	}
+	if s := statsOf(t, result, 2); s.Deaths != 1 {
+		t.Errorf("victim Deaths = %d, want 1", s.Deaths)
+	}
+}
+
+// Demos credit the explosion to the planter without a weapon once the
+// timer is out. Only -count-bomb-kills makes that a normal kill too, and
+// a T caught in the blast is no BombKill.
+func TestBombExplosionKill(t *testing.T) {
+	for _, count := range []bool{false, true} {
+		d := newFakeDemo()
+		planter := d.addPlayer(1, "t1", common.TeamTerrorists)
+		mate := d.addPlayer(2, "t2", common.TeamTerrorists)
+		ct := d.addPlayer(3, "ct", common.TeamCounterTerrorists)
+		d.startMatch()
+		d.round(common.TeamTerrorists, func() {
+			d.plant(planter)
+			d.wait(defaultBombTime)
+			d.dispatch(events.BombExplode{BombEvent: events.BombEvent{Player: planter, Site: events.BombsiteA}})
+			d.kill(planter, ct, common.EqUnknown)
+			d.kill(planter, mate, common.EqUnknown)
+		})
+		opts := DefaultOptions()
+		opts.CountBombKills = count
+
+		result := d.parse(t, opts)
+		s := statsOf(t, result, 1)
+		wantKills := 0
+		if count {
+			wantKills = 1 // Not the teammate, that's a team kill
+		}
+		if s.BombKills != 1 || s.Kills != wantKills {
+			t.Errorf("-count-bomb-kills %v: BombKills, Kills = %d, %d, want 1, %d", count, s.BombKills, s.Kills, wantKills)
+		}
+		if s := statsOf(t, result, 3); s.Deaths != 1 {
+			t.Errorf("-count-bomb-kills %v: victim Deaths = %d, want 1", count, s.Deaths)
+		}
+	}
+}
+
+// A team kill or suicide before the first enemy kill doesn't open the
+// round: the opening duel is the first kill across sides
+func TestTeamKillDoesNotOpenRound(t *testing.T) {
+	d := newFakeDemo()
+	t1 := d.addPlayer(1, "t1", common.TeamTerrorists)
+	t2 := d.addPlayer(2, "t2", common.TeamTerrorists)
+	t3 := d.addPlayer(3, "t3", common.TeamTerrorists)
+	ct1 := d.addPlayer(4, "ct1", common.TeamCounterTerrorists)
+	d.addPlayer(5, "ct2", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.kill(t1, t2, common.EqAK47)
+		d.kill(t3, t3, common.EqHE)
+		d.wait(10 * time.Second)
+		d.kill(t1, ct1, common.EqAK47)
+	})
+
+	result := d.parse(t, DefaultOptions())
+	want := []OpeningDuel{{Round: 1, Winner: 1, Loser: 4}}
+	if !reflect.DeepEqual(result.OpeningDuels, want) {
+		t.Errorf("OpeningDuels = %+v, want %+v", result.OpeningDuels, want)
+	}
+	if s := statsOf(t, result, 1); s.EntryKills != 1 {
+		t.Errorf("killer EntryKills = %d, want 1", s.EntryKills)
+	}
+	for _, id := range []uint64{2, 3} {
+		if s := statsOf(t, result, id); s.EntryDeaths != 0 {
+			t.Errorf("%d: EntryDeaths = %d, want 0", id, s.EntryDeaths)
+		}
+	}
+	if s := statsOf(t, result, 4); s.EntryDeaths != 1 {
+		t.Errorf("enemy EntryDeaths = %d, want 1", s.EntryDeaths)
+	}
+	if result.AvgTSideFirstContact != 10 {
+		t.Errorf("AvgTSideFirstContact = %v, want 10, from the enemy kill", result.AvgTSideFirstContact)
+	}
+}
+
+// A 1v3 that comes down to a 1v1 is two clutches, one per side: the CT
+// who lost it has a 1v3 loss, the T who won the 1v1 a clutch win
+func TestClutchOnBothSides(t *testing.T) {
+	d := newFakeDemo()
+	t1 := d.addPlayer(1, "t1", common.TeamTerrorists)
+	t2 := d.addPlayer(2, "t2", common.TeamTerrorists)
+	t3 := d.addPlayer(3, "t3", common.TeamTerrorists)
+	ct1 := d.addPlayer(4, "ct1", common.TeamCounterTerrorists)
+	ct2 := d.addPlayer(5, "ct2", common.TeamCounterTerrorists)
+	ct3 := d.addPlayer(6, "ct3", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.kill(t1, ct2, common.EqAK47)
+		d.kill(t1, ct3, common.EqAK47)
+		d.kill(ct1, t2, common.EqM4A4)
+		d.kill(ct1, t3, common.EqM4A4)
+		d.kill(t1, ct1, common.EqAK47)
+	})
+
+	result := d.parse(t, DefaultOptions())
+	ct := statsOf(t, result, 4)
+	if ct.ClutchWins != 0 || !reflect.DeepEqual(ct.ClutchLosses, map[int]int{3: 1}) {
+		t.Errorf("CT ClutchWins, ClutchLosses = %d, %v, want 0, a 1v3 loss", ct.ClutchWins, ct.ClutchLosses)
+	}
+	tt := statsOf(t, result, 1)
+	if tt.ClutchWins != 1 || len(tt.ClutchLosses) != 0 {
+		t.Errorf("T ClutchWins, ClutchLosses = %d, %v, want 1, none", tt.ClutchWins, tt.ClutchLosses)
+	}
+	for _, s := range []PlayerStats{ct, tt} {
+		if s.TimesLastAlive != 1 || s.OneVOneWins+s.OneVOneLosses != 1 {
+			t.Errorf("%d: TimesLastAlive, 1v1s = %d, %d, want 1, 1", s.SteamID, s.TimesLastAlive, s.OneVOneWins+s.OneVOneLosses)
+		}
+	}
+}
+
+// Buy type win rates belong to the team, not the side: the halftime flip
+// doesn't merge both teams' T rounds
+func TestBuyTypeWinRatesCrossHalftime(t *testing.T) {
+	d := newFakeDemo()
+	a := []*common.Player{d.addPlayer(1, "a1", common.TeamTerrorists), d.addPlayer(2, "a2", common.TeamTerrorists)}
+	b := []*common.Player{d.addPlayer(3, "b1", common.TeamCounterTerrorists), d.addPlayer(4, "b2", common.TeamCounterTerrorists)}
+	buy := func(team []*common.Player, value int) {
+		for _, pl := range team {
+			pl.Entity.(*fakeEntity).props["m_unFreezetimeEndEquipmentValue"] = value
+		}
+	}
+	d.startMatch()
+	buy(a, 5000)
+	d.round(common.TeamTerrorists, func() {})
+	d.frame(func() {
+		for _, pl := range d.players {
+			if pl.Team == common.TeamTerrorists {
+				pl.Team = common.TeamCounterTerrorists
+			} else {
+				pl.Team = common.TeamTerrorists
+			}
+		}
+		d.dispatch(events.TeamSideSwitch{})
+		buy(b, 5000)
+	})
+	d.round(common.TeamTerrorists, func() {})
+
+	result := d.parse(t, DefaultOptions())
+	want := []BuyTypeWinRate{
+		{TeamNum: 2, BuyType: "eco", Rounds: 1, WinRate: 0},    // b, on CT in the first half
+		{TeamNum: 2, BuyType: "full", Rounds: 1, WinRate: 100}, // b, on T in the second
+		{TeamNum: 3, BuyType: "full", Rounds: 2, WinRate: 50},  // a, both halves
+	}
+	if !reflect.DeepEqual(result.BuyTypeWinRates, want) {
+		t.Errorf("BuyTypeWinRates = %+v, want %+v", result.BuyTypeWinRates, want)
+	}
+}
+
+// -teams-only output has the team totals, score and map, and nothing per
+// player or per round
+func TestTeamsOnly(t *testing.T) {
+	d := newFakeDemo()
+	t1 := d.addPlayer(1, "t1", common.TeamTerrorists)
+	ct1 := d.addPlayer(2, "ct1", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.plant(t1)
+		d.kill(t1, ct1, common.EqAK47)
+	})
+	opts := DefaultOptions()
+	opts.TeamsOnly = true
+	opts.Killfeed = true
+
+	out, err := json.Marshal(d.parse(t, opts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	delete(got, "warnings") // Two players aren't a full match
+	var keys []string
+	for k := range got {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	want := []string{"map_name", "schema_version", "score_ct", "score_str", "score_t", "teams", "winner"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+	if got["winner"] != float64(common.TeamTerrorists) {
+		t.Errorf("winner = %v, want %d", got["winner"], common.TeamTerrorists)
+	}
+}
+
+// By default a player who left before the end keeps their row, and a bomb
+// explosion kill counts as a kill; both are flags a test could forget
+func TestDefaultOptions(t *testing.T) {
+	d := newFakeDemo()
+	planter := d.addPlayer(1, "t1", common.TeamTerrorists)
+	leaver := d.addPlayer(2, "t2", common.TeamTerrorists)
+	ct := d.addPlayer(3, "ct", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.plant(planter)
+		d.wait(defaultBombTime)
+		d.kill(planter, ct, common.EqUnknown)
+	})
+	d.frame(func() { leaver.IsConnected = false })
+
+	result := d.parse(t, DefaultOptions())
+	if s := statsOf(t, result, 1); s.Kills != 1 || s.BombKills != 1 {
+		t.Errorf("Kills, BombKills = %d, %d, want 1, 1", s.Kills, s.BombKills)
+	}
+	if s := statsOf(t, result, 2); s.Connected {
+		t.Errorf("leaver Connected = true, want false")
+	}
+}
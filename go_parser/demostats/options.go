@@ -0,0 +1,65 @@
+package demostats
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultTradeWindow is how long after a teammate's death a kill on their
+// killer still counts as a trade. Overridable with -trade-window.
+const DefaultTradeWindow = 5 * time.Second
+
+// Options holds the flag-controlled settings for a single parse. Start from
+// DefaultOptions, the zero value turns most stats off.
+type Options struct {
+	TradeWindow         time.Duration
+	PlayerFilter        map[uint64]bool // Empty = everyone
+	TrackSpotting       bool
+	Groups              map[string]bool // Enabled StatGroups
+	Precision           int             // Decimal places for derived floats, -1 = per-field defaults
+	IncludeDisconnected bool
+	WeaponByDamage      bool // Credit WeaponKills to the weapon that did the most damage that life
+	Killfeed            bool
+	Rounds              bool
+	NadeSpots           bool
+	SortBy              string          // score, kills, adr, rating or kd
+	Top                 int             // Players to keep after sorting, 0 = all
+	MinMultiKill        int             // Smallest kill count listed in MultiKillRounds
+	MaxRound            int             // Stop after this round, 0 = parse everything
+	CountBombKills      bool            // Bomb kills also count as Kills for whoever the game credits
+	OnlyMaps            map[string]bool // Lowercase header map names to parse, empty = all
+	TeamsOnly           bool            // Drop the per-player stats, keep team totals
+}
+
+// round rounds a derived stat to the -precision decimal places, or to
+// defaultPlaces when the flag isn't set.
+func (o Options) round(x float64, defaultPlaces int) float64 {
+	places := defaultPlaces
+	if o.Precision >= 0 {
+		places = o.Precision
+	}
+	pow := math.Pow(10, float64(places))
+	return math.Round(x*pow) / pow
+}
+
+// StatGroups are the stat groups selectable with -stats. "basic" (kills,
+// damage, rounds) is always computed since the others build on it.
+var StatGroups = []string{"basic", "economy", "grenades", "positions"}
+
+// DefaultOptions are what a run without flags parses with, the flags take
+// their defaults from here
+func DefaultOptions() Options {
+	groups := make(map[string]bool)
+	for _, g := range StatGroups {
+		groups[g] = true
+	}
+	return Options{
+		TradeWindow:         DefaultTradeWindow,
+		Groups:              groups,
+		Precision:           -1,
+		IncludeDisconnected: true,
+		SortBy:              "score",
+		MinMultiKill:        2,
+		CountBombKills:      true,
+	}
+}
@@ -0,0 +1,1618 @@
+// Package demostats turns a CS:GO or CS2 GOTV demo into match stats: a
+// scoreboard per player, team totals and, optionally, per-round detail.
+// It is the library behind the go_parser command.
+package demostats
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/geo/r3"
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// grenadePrices are the buy menu prices used for UtilityValueSpent
+var grenadePrices = map[common.EquipmentType]int{
+	common.EqHE:         300,
+	common.EqFlash:      200,
+	common.EqSmoke:      300,
+	common.EqMolotov:    400,
+	common.EqIncendiary: 500,
+	common.EqDecoy:      50,
+}
+
+// minSaveEquipmentValue is the equipment value a survivor of a lost round
+// needs for it to count as a save, roughly the cheapest primary.
+const minSaveEquipmentValue = 1000
+
+// clutchImpact is what a clutch win adds to a round's impact when picking the
+// round MVP. Kills, traded entry deaths, plants and defuses add 1 each.
+const clutchImpact = 2
+
+// maxLossStreak is the losing streak that earns the highest loss bonus
+// ($1400, +$500 per further loss up to $3400). Winning resets the streak,
+// as does switching sides.
+const maxLossStreak = 5
+
+// Grenade spots: detonations in the same nadeSpotGrid-unit cell (about a
+// doorway wide) count as one spot, and only the maxNadeSpots most used
+// spots that were hit at least twice are reported.
+const (
+	nadeSpotGrid = 150.0
+	maxNadeSpots = 20
+)
+
+// Competitive defaults for the round clock when a demo has no game rules
+const (
+	defaultRoundTime = 115 * time.Second
+	defaultBombTime  = 40 * time.Second
+)
+
+// lowHPKill is the most health a killer can have for LowHPKills
+const lowHPKill = 20
+
+// fireTickGap is the longest gap between fire hits on the same victim that
+// still counts as standing in the flames rather than a fresh burn.
+const fireTickGap = time.Second
+
+// flashKillWindow is how long after being flashed an enemy's death still
+// counts towards the thrower's FlashesLeadingToKills
+const flashKillWindow = 3 * time.Second
+
+// smokeRadius is roughly how far a smoke cloud reaches from where the
+// grenade popped, for telling damage through smoke
+const smokeRadius = 144.0
+
+// Debug is the log for parsing diagnostics (event counts, match start,
+// halftime, rounds). Silent until given an output, go_parser -verbose
+// sends it to stderr.
+var Debug = log.New(io.Discard, "DEBUG ", log.Ltime)
+
+// Parse reads a demo from r into a MatchResult. If onRound isn't nil it's
+// called with each round's summary as soon as the round ends, so callers
+// can stream results while the rest of the demo is still being parsed.
+// An error means nothing usable came out of the demo. A demo that breaks
+// off after some rounds still gives those, with a warning.
+func Parse(r io.Reader, opts Options, onRound func(RoundSummary)) (MatchResult, error) {
+	p, err := newParser(r)
+	if err != nil {
+		return MatchResult{}, err
+	}
+	defer p.Close()
+	return parseMatch(p, opts, onRound)
+}
+
+// parseMatch is Parse for p, which mustn't have read anything yet
+func parseMatch(p demoinfocs.Parser, opts Options, onRound func(RoundSummary)) (MatchResult, error) {
+	// -only-maps: the header is enough to know we can skip the demo
+	if len(opts.OnlyMaps) > 0 {
+		header, err := parseHeader(p)
+		if err != nil {
+			return MatchResult{}, fmt.Errorf("header: %w", err)
+		}
+		if !opts.OnlyMaps[strings.ToLower(header.MapName)] {
+			return MatchResult{SchemaVersion: SchemaVersion, MapName: displayMapName(header.MapName), Skipped: true}, nil
+		}
+	}
+
+	// Stats accumulation
+	stats := make(map[uint64]*PlayerStats) // Keyed by SteamID64
+
+	// Helper to get or create stats
+	getStats := func(p *common.Player) *PlayerStats {
+		if p == nil {
+			return nil
+		}
+		if _, ok := stats[p.SteamID64]; !ok {
+			stats[p.SteamID64] = &PlayerStats{
+				Player:          p.Name,
+				SteamID:         p.SteamID64,
+				TeamNum:         int(p.Team),
+				MultiKills:      make(map[int]int),
+				WeaponKills:     make(map[string]int),
+				GrenadesThrown:  make(map[string]int),
+				KillsByCategory: make(map[string]int),
+				ClutchLosses:    make(map[int]int),
+				WeaponStats:     make(map[string]WeaponStat),
+			}
+		}
+		// Update name/team just in case
+		s := stats[p.SteamID64]
+		if p.Name != "" {
+			s.Player = p.Name
+			if !slices.Contains(s.AllNames, p.Name) {
+				s.AllNames = append(s.AllNames, p.Name)
+			}
+		}
+		if p.Team > 0 {
+			s.TeamNum = int(p.Team)
+		}
+		return s
+	}
+
+	// Variables for round tracking
+	// var currentRoundDamage map[uint64]int // Unused
+	var totalRounds int
+	var scoreT, scoreCT int
+	var economyTimeline []RoundEconomy
+	var killfeed []KillEvent
+	var roundSummaries []RoundSummary
+	var openingDuels []OpeningDuel
+	roundImpact := make(map[uint64]int) // Non-kill round impact, for the round summaries
+
+	// Team audit: everyone's side at match start, halftime and demo end,
+	// plus every switch in between, to tell a sub from the halftime flip
+	logTeams := func(when string) {
+		for _, pl := range p.GameState().Participants().Playing() {
+			Debug.Printf("team at %s: %s (%d) on %d", when, pl.Name, pl.SteamID64, pl.Team)
+		}
+	}
+
+	// Halftime: first-half stats are moved aside and accumulation restarts
+	var firstHalfStats map[uint64]*PlayerStats
+	var firstHalfRounds int
+	switchHalves := func() {
+		if firstHalfStats != nil || !p.GameState().IsMatchStarted() {
+			return
+		}
+		firstHalfStats = stats
+		firstHalfRounds = totalRounds
+		stats = make(map[uint64]*PlayerStats)
+		Debug.Printf("halftime at tick %d after %d rounds", p.GameState().IngameTick(), totalRounds)
+		logTeams("halftime")
+	}
+	p.RegisterEventHandler(func(e events.GameHalfEnded) { switchHalves() })
+	p.RegisterEventHandler(func(e events.TeamSideSwitch) { switchHalves() })
+
+	// Loss bonus state per side, see maxLossStreak
+	lossStreak := make(map[common.Team]int)
+	lossBonus := map[common.Team]*LossBonusTotals{
+		common.TeamTerrorists:        {Side: int(common.TeamTerrorists)},
+		common.TeamCounterTerrorists: {Side: int(common.TeamCounterTerrorists)},
+	}
+	if opts.Groups["economy"] {
+		resetLossStreaks := func() { lossStreak = make(map[common.Team]int) }
+		p.RegisterEventHandler(func(e events.GameHalfEnded) { resetLossStreaks() })
+		p.RegisterEventHandler(func(e events.TeamSideSwitch) { resetLossStreaks() })
+	}
+
+	// Round-specific temp data
+	var roundKills map[uint64]int
+	var firstKillOccurred bool
+	var entryVictim uint64 // Who died to the opening kill
+
+	// Trade / KAST Tracking State
+	type roundDeath struct {
+		victim     uint64
+		victimTeam common.Team
+		killer     uint64
+		time       time.Duration
+	}
+	var roundDeaths []roundDeath
+	var roundAssisted, roundDied, roundTraded map[uint64]bool
+	var roundDeathTime map[uint64]time.Duration
+	var roundDamage map[uint64]int // Damage to enemies this round
+	var roundBuyType map[common.Team]string
+
+	// Each team's buy type and result per round, economy group. A round is
+	// tied to one of the team's players so it is credited to that player's
+	// end-of-match team rather than the side, which flips at halftime.
+	type buyRound struct {
+		player  uint64
+		buyType string
+		won     bool
+	}
+	var buyRounds []buyRound
+	var teamHadDeath map[common.Team]bool
+	var roundSpawned map[uint64]bool // Alive at freezetime end, coaches and spectators never are
+	var roundLiveTime time.Duration  // When freezetime ended, "time into round" is relative to this
+	var bombPlantTime time.Duration  // 0 until the bomb is planted this round
+	var plantRounds PlantRounds
+	var bombPlanter *common.Player
+	var roundBomb *RoundBomb // Only with round summaries
+	var firstContactTotal float64
+	var firstContactRounds int
+	// Same, split by the side that took the opening kill
+	sideFirstContactTotal := make(map[common.Team]float64)
+	sideFirstContactRounds := make(map[common.Team]int)
+
+	// Damage per weapon dealt to each victim this life, only with -weapon-by-damage
+	type lifeDamageKey struct{ victim, attacker uint64 }
+	lifeDamage := make(map[lifeDamageKey]map[string]int)
+
+	// Enemies each attacker damaged this round
+	roundVictims := make(map[uint64]map[uint64]bool)
+
+	// Whether any of an attacker's damage to a victim this round went
+	// through a smoke or was dealt while flashed, for SmokeAssists/BlindAssists
+	smokeDamage := make(map[lifeDamageKey]bool)
+	blindDamage := make(map[lifeDamageKey]bool)
+	activeSmokes := make(map[int]r3.Vector) // By grenade entity
+
+	// Last enemy flash on each victim, and the flashes already credited with
+	// a kill. A flash is its thrower and the tick it blinded people on.
+	type flashKey struct {
+		thrower uint64
+		tick    int
+	}
+	type flashHit struct {
+		key     flashKey
+		thrower *common.Player
+		at      time.Duration
+	}
+	lastFlash := make(map[uint64]flashHit)
+	fraggedFlashes := make(map[flashKey]bool)
+
+	// Last fire hit per victim/thrower, to tell the first burn from standing in it
+	fireContact := make(map[lifeDamageKey]time.Duration)
+
+	// Tick of each attacker's last counted hit. Shotgun pellets (and one
+	// bullet through two players) all land on the tick of the shot.
+	lastHitTick := make(map[uint64]int)
+
+	// Collateral Tracking State: the previous kill's shot
+	var lastKillTick int
+	var lastKillKiller uint64
+	var lastKillWeapon common.EquipmentType
+	var lastKillCounted bool
+
+	// Clutch Tracking State: per team, as both sides can end up 1vX
+	type clutch struct {
+		player    *common.Player
+		opponents int // Enemies alive when the clutch started
+	}
+	clutches := make(map[common.Team]clutch)
+	var oneVOne []*common.Player // the last two alive once a round is down to 1v1
+
+	// roundClock is what the in-game timer shows: round time left, or the
+	// bomb timer after a plant. Falls back to the defaults if the demo
+	// doesn't carry the game rules.
+	roundClock := func() time.Duration {
+		rules := p.GameState().Rules()
+		var left time.Duration
+		if bombPlantTime > 0 {
+			c4, err := rules.BombTime()
+			if err != nil {
+				c4 = defaultBombTime
+			}
+			left = c4 - (p.CurrentTime() - bombPlantTime)
+		} else {
+			roundTime, err := rules.RoundTime()
+			if err != nil {
+				roundTime = defaultRoundTime
+			}
+			left = roundTime - (p.CurrentTime() - roundLiveTime)
+		}
+		return max(left, 0)
+	}
+
+	// Init round data. Also done once up front: if the first live round's
+	// kills come before its RoundStart, the maps must exist and the opening
+	// kill must still count as one.
+	resetRound := func() {
+		roundKills = make(map[uint64]int)
+		firstKillOccurred = false
+		entryVictim = 0
+		roundDeaths = nil
+		roundAssisted = make(map[uint64]bool)
+		roundDied = make(map[uint64]bool)
+		roundDeathTime = make(map[uint64]time.Duration)
+		roundDamage = make(map[uint64]int)
+		roundBuyType = make(map[common.Team]string)
+		roundTraded = make(map[uint64]bool)
+		teamHadDeath = make(map[common.Team]bool)
+		roundSpawned = make(map[uint64]bool)
+		// Fallback if freezetime end is missed, using the server's own freezetime
+		roundLiveTime = p.CurrentTime()
+		if freeze, err := p.GameState().Rules().FreezeTime(); err == nil {
+			roundLiveTime += freeze
+		}
+		bombPlantTime = 0
+		bombPlanter = nil
+		roundBomb = nil
+		lifeDamage = make(map[lifeDamageKey]map[string]int)
+		roundVictims = make(map[uint64]map[uint64]bool)
+		smokeDamage = make(map[lifeDamageKey]bool)
+		blindDamage = make(map[lifeDamageKey]bool)
+		activeSmokes = make(map[int]r3.Vector)
+		lastFlash = make(map[uint64]flashHit)
+		roundImpact = make(map[uint64]int)
+		clutches = make(map[common.Team]clutch)
+		oneVOne = nil
+	}
+	resetRound()
+	p.RegisterEventHandler(func(e events.RoundStart) { resetRound() })
+
+	// Diagnostics: how often each event fired, and when the match went live
+	eventCounts := make(map[string]int)
+	if Debug.Writer() != io.Discard {
+		p.RegisterEventHandler(func(e any) { eventCounts[fmt.Sprintf("%T", e)]++ })
+	}
+	// Match start and mid-match side switches, for the team audit
+	p.RegisterEventHandler(func(e events.MatchStart) {
+		Debug.Printf("match start at tick %d", p.GameState().IngameTick())
+		logTeams("match start")
+	})
+	p.RegisterEventHandler(func(e events.PlayerTeamChange) {
+		if e.Player != nil && p.GameState().IsMatchStarted() {
+			Debug.Printf("team switch at tick %d: %s (%d) %d -> %d", p.GameState().IngameTick(), e.Player.Name, e.Player.SteamID64, e.OldTeam, e.NewTeam)
+		}
+	})
+
+	// Recoverable parser problems, deduplicated since some repeat every tick
+	var warnings []string
+	seenWarnings := make(map[string]bool)
+	p.RegisterEventHandler(func(e events.ParserWarn) {
+		if !seenWarnings[e.Message] {
+			seenWarnings[e.Message] = true
+			warnings = append(warnings, e.Message)
+		}
+	})
+
+	p.RegisterEventHandler(func(e events.RoundFreezetimeEnd) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		roundLiveTime = p.CurrentTime()
+		for _, pl := range p.GameState().Participants().Playing() {
+			if pl.IsAlive() {
+				roundSpawned[pl.SteamID64] = true
+			}
+		}
+	})
+
+	// Economy snapshots: money going into the round, buys once freezetime is over
+	if opts.Groups["economy"] {
+		p.RegisterEventHandler(func(e events.RoundStart) {
+			if !p.GameState().IsMatchStarted() {
+				return
+			}
+			for _, pl := range p.GameState().Participants().Playing() {
+				if s := getStats(pl); s != nil {
+					s.StartMoneyTotal += pl.Money()
+					s.StartMoneyRounds++
+				}
+			}
+		})
+		p.RegisterEventHandler(func(e events.RoundFreezetimeEnd) {
+			if !p.GameState().IsMatchStarted() {
+				return
+			}
+			eco := RoundEconomy{Round: totalRounds + 1}
+			players := make(map[common.Team]int)
+			for _, pl := range p.GameState().Participants().Playing() {
+				switch pl.Team {
+				case common.TeamTerrorists:
+					eco.TEquipmentValue += pl.EquipmentValueFreezeTimeEnd()
+					eco.TMoney += pl.Money()
+					players[pl.Team]++
+				case common.TeamCounterTerrorists:
+					eco.CTEquipmentValue += pl.EquipmentValueFreezeTimeEnd()
+					eco.CTMoney += pl.Money()
+					players[pl.Team]++
+				}
+				if pl.IsAlive() {
+					if s := getStats(pl); s != nil {
+						s.EquipValueTotal += pl.EquipmentValueFreezeTimeEnd()
+						s.EquipValueRounds++
+					}
+				}
+			}
+			economyTimeline = append(economyTimeline, eco)
+
+			// A team's buy is classified by its average player
+			if n := players[common.TeamTerrorists]; n > 0 {
+				roundBuyType[common.TeamTerrorists] = buyType(eco.TEquipmentValue / n)
+			}
+			if n := players[common.TeamCounterTerrorists]; n > 0 {
+				roundBuyType[common.TeamCounterTerrorists] = buyType(eco.CTEquipmentValue / n)
+			}
+		})
+	}
+
+	// Track Deaths for Clutch Logic
+	p.RegisterEventHandler(func(e events.Kill) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+
+		// Existing Kill Logic
+		kStats := getStats(e.Killer)
+		vStats := getStats(e.Victim)
+		aStats := getStats(e.Assister)
+
+		if opts.Killfeed {
+			ke := KillEvent{
+				Round:         totalRounds + 1,
+				Time:          opts.round((p.CurrentTime() - roundLiveTime).Seconds(), 1),
+				Clock:         opts.round(roundClock().Seconds(), 1),
+				Headshot:      e.IsHeadshot,
+				Wallbang:      e.IsWallBang(),
+				NoScope:       e.NoScope,
+				ThroughSmoke:  e.ThroughSmoke,
+				AttackerBlind: e.AttackerBlind,
+				AssistedFlash: e.AssistedFlash,
+			}
+			if e.Killer != nil {
+				ke.Killer = e.Killer.SteamID64
+			}
+			if e.Victim != nil {
+				ke.Victim = e.Victim.SteamID64
+			}
+			if e.Assister != nil {
+				ke.Assister = e.Assister.SteamID64
+			}
+			ke.Weapon = worldWeapon
+			if e.Weapon != nil {
+				ke.Weapon = weaponName(e.Weapon)
+			}
+			killfeed = append(killfeed, ke)
+		}
+
+		// Suicides (own molotov, fall damage, world) count as a death but not a kill
+		if e.Killer == nil || (e.Victim != nil && e.Killer.SteamID64 == e.Victim.SteamID64) {
+			kStats = nil
+		}
+
+		// Bomb kills: the C4 itself, or a weaponless death once the bomb timer ran out.
+		// Credited to the planter, and only counted as normal kills with -count-bomb-kills.
+		isBombKill := (e.Weapon != nil && e.Weapon.Type == common.EqBomb) ||
+			((e.Weapon == nil || e.Weapon.Type == common.EqUnknown) && bombPlantTime > 0 && roundClock() == 0)
+		if isBombKill {
+			if e.Victim != nil && e.Victim.Team == common.TeamCounterTerrorists {
+				if s := getStats(bombPlanter); s != nil {
+					s.BombKills++
+				}
+			}
+			if !opts.CountBombKills {
+				kStats = nil
+			}
+		}
+
+		// Team kills are counted as such and, like suicides, nothing else
+		if kStats != nil && e.Victim != nil && e.Killer.Team == e.Victim.Team {
+			kStats.TeamKills++
+			kStats = nil
+		}
+
+		if kStats != nil {
+			kStats.Kills++
+			if roundKills[e.Killer.SteamID64] == 0 {
+				kStats.FirstKillTimeTotal += (p.CurrentTime() - roundLiveTime).Seconds()
+				kStats.FirstKillRounds++
+			}
+			roundKills[e.Killer.SteamID64]++
+
+			if e.IsHeadshot {
+				kStats.Headshots++
+			}
+
+			// Weapon Stats. No weapon means the world did it (bomb, fall)
+			if e.Weapon == nil {
+				kStats.WeaponKills[worldWeapon]++
+			} else {
+				wName := weaponName(e.Weapon)
+				if opts.WeaponByDamage && e.Victim != nil {
+					best := 0
+					for w, dmg := range lifeDamage[lifeDamageKey{e.Victim.SteamID64, e.Killer.SteamID64}] {
+						if dmg > best || (dmg == best && w < wName) {
+							best, wName = dmg, w
+						}
+					}
+				}
+				kStats.WeaponKills[wName]++
+				ws := kStats.WeaponStats[weaponName(e.Weapon)]
+				ws.Kills++
+				if e.IsHeadshot {
+					ws.Headshots++
+				}
+				kStats.WeaponStats[weaponName(e.Weapon)] = ws
+				if category := weaponCategory(e.Weapon); category != "" {
+					kStats.KillsByCategory[category]++
+				}
+				if e.Weapon.Type == common.EqZeus {
+					kStats.ZeusKills++
+				}
+			}
+
+			// Collateral: same killer, same gun, same tick as the previous kill
+			if e.Weapon != nil {
+				tick := p.GameState().IngameTick()
+				class := e.Weapon.Class()
+				isGun := class == common.EqClassPistols || class == common.EqClassSMG || class == common.EqClassHeavy || class == common.EqClassRifle
+				if isGun && tick == lastKillTick && e.Killer.SteamID64 == lastKillKiller && e.Weapon.Type == lastKillWeapon {
+					if !lastKillCounted {
+						kStats.CollateralKills++
+						lastKillCounted = true
+					}
+				} else {
+					lastKillTick = tick
+					lastKillKiller = e.Killer.SteamID64
+					lastKillWeapon = e.Weapon.Type
+					lastKillCounted = false
+				}
+			}
+
+			// Jump Kills (best-effort, needs the killer's entity)
+			if opts.Groups["positions"] && e.Killer.Entity != nil && e.Killer.IsAirborne() {
+				kStats.JumpKills++
+			}
+
+			// Kill Distance. Without an entity Position() is the origin.
+			if opts.Groups["positions"] && e.Victim != nil && e.Killer.Entity != nil && e.Victim.Entity != nil {
+				dist := e.Killer.Position().Sub(e.Victim.Position()).Norm()
+				kStats.KillDistanceTotal += dist
+				kStats.KillDistanceCount++
+				if dist > kStats.MaxKillDistance {
+					kStats.MaxKillDistance = dist
+				}
+			}
+
+			// Kills on a saving or forcing enemy, by the victim's team's buy this round
+			if opts.Groups["economy"] && e.Victim != nil {
+				if bt := roundBuyType[e.Victim.Team]; bt == "eco" || bt == "force" {
+					kStats.EcoKills++
+				}
+			}
+
+			// Man advantage going into the kill, the victim still counts as alive
+			if e.Victim != nil {
+				own, enemy := 0, 1
+				for _, m := range p.GameState().Team(e.Killer.Team).Members() {
+					if m.IsAlive() {
+						own++
+					}
+				}
+				for _, m := range p.GameState().Team(e.Victim.Team).Members() {
+					if m.IsAlive() && m.SteamID64 != e.Victim.SteamID64 {
+						enemy++
+					}
+				}
+				switch {
+				case own > enemy:
+					kStats.KillsWhenAhead++
+				case own < enemy:
+					kStats.KillsWhenBehind++
+				default:
+					kStats.KillsWhenEven++
+				}
+			}
+
+			// Killer's health when the kill happened
+			if e.Killer.Entity != nil {
+				hp := e.Killer.Health()
+				kStats.HPAtKillTotal += hp
+				kStats.HPAtKillCount++
+				if hp <= lowHPKill {
+					kStats.LowHPKills++
+				}
+			}
+
+			// Kills through smoke, and one-ways: the killer outside any smoke,
+			// the victim inside one. The latter is a guess from positions.
+			if opts.Groups["grenades"] && e.Victim != nil && e.ThroughSmoke {
+				kStats.SmokeKills++
+				killerPos, victimPos := e.Killer.Position(), e.Victim.Position()
+				killerInside, victimInside := false, false
+				for _, smoke := range activeSmokes {
+					killerInside = killerInside || killerPos.Sub(smoke).Norm() <= smokeRadius
+					victimInside = victimInside || victimPos.Sub(smoke).Norm() <= smokeRadius
+				}
+				if victimInside && !killerInside {
+					kStats.OneWayKills++
+				}
+			}
+
+			// Entry Kill Logic: the first enemy kill opens the round, team
+			// kills and suicides never get here
+			if !firstKillOccurred {
+				kStats.EntryKills++
+				kStats.OpeningImpact += openingWeight(e.Victim)
+				if e.Victim != nil {
+					openingDuels = append(openingDuels, OpeningDuel{Round: totalRounds + 1, Winner: e.Killer.SteamID64, Loser: e.Victim.SteamID64})
+				}
+				if e.Killer.Team == common.TeamTerrorists {
+					kStats.EntryKillsT++
+				} else {
+					kStats.EntryKillsCT++
+				}
+				if vStats != nil {
+					vStats.EntryDeaths++
+					entryVictim = e.Victim.SteamID64
+					if e.Victim.Team == common.TeamTerrorists {
+						vStats.EntryDeathsT++
+					} else {
+						vStats.EntryDeathsCT++
+					}
+				}
+				firstKillOccurred = true
+				contact := (p.CurrentTime() - roundLiveTime).Seconds()
+				firstContactTotal += contact
+				firstContactRounds++
+				sideFirstContactTotal[e.Killer.Team] += contact
+				sideFirstContactRounds[e.Killer.Team]++
+				kStats.OpeningDuelTimeTotal += contact
+				kStats.OpeningDuelCount++
+				if vStats != nil {
+					vStats.OpeningDuelTimeTotal += contact
+					vStats.OpeningDuelCount++
+				}
+			}
+		}
+		if vStats != nil {
+			vStats.Deaths++
+			if opts.Groups["economy"] && e.Victim.Entity != nil {
+				vStats.ValueLostToDeath += e.Victim.EquipmentValueCurrent()
+			}
+			vStats.DamageBeforeDeathTotal += roundDamage[e.Victim.SteamID64]
+			vStats.DamageBeforeDeathRounds++
+		}
+		if opts.WeaponByDamage && e.Victim != nil {
+			for key := range lifeDamage {
+				if key.victim == e.Victim.SteamID64 {
+					delete(lifeDamage, key)
+				}
+			}
+		}
+
+		// Trade Logic: the victim recently killed one of the killer's teammates
+		now := p.CurrentTime()
+		if kStats != nil && e.Victim != nil {
+			traded := false
+			for _, d := range roundDeaths {
+				if d.killer == e.Victim.SteamID64 && d.victimTeam == e.Killer.Team && now-d.time <= opts.TradeWindow {
+					if d.victim == entryVictim && !roundTraded[d.victim] {
+						if s := stats[d.victim]; s != nil {
+							s.TimesEntryTraded++
+							roundImpact[d.victim]++
+						}
+					}
+					roundTraded[d.victim] = true
+					traded = true
+				}
+			}
+			if traded {
+				kStats.TradeKills++
+			}
+		}
+		if e.Victim != nil {
+			if !teamHadDeath[e.Victim.Team] {
+				teamHadDeath[e.Victim.Team] = true
+				if vStats != nil {
+					vStats.FirstDeaths++
+				}
+			}
+			roundDied[e.Victim.SteamID64] = true
+			roundDeathTime[e.Victim.SteamID64] = now
+			d := roundDeath{victim: e.Victim.SteamID64, victimTeam: e.Victim.Team, time: now}
+			if e.Killer != nil {
+				d.killer = e.Killer.SteamID64
+			}
+			roundDeaths = append(roundDeaths, d)
+		}
+
+		if aStats != nil {
+			roundAssisted[e.Assister.SteamID64] = true
+			aStats.Assists++
+			if e.AssistedFlash {
+				aStats.FlashAssists++
+			} else {
+				aStats.DamageAssists++
+				if e.Victim != nil {
+					key := lifeDamageKey{e.Victim.SteamID64, e.Assister.SteamID64}
+					if smokeDamage[key] {
+						aStats.SmokeAssists++
+					}
+					if blindDamage[key] {
+						aStats.BlindAssists++
+					}
+				}
+			}
+		}
+
+		if e.Victim == nil {
+			return
+		}
+
+		// Flash-to-frag: the victim was flashed by the killer's side shortly before
+		if hit, ok := lastFlash[e.Victim.SteamID64]; ok && e.Killer != nil && e.Killer.Team == hit.thrower.Team && p.CurrentTime()-hit.at <= flashKillWindow && !fraggedFlashes[hit.key] {
+			fraggedFlashes[hit.key] = true
+			if s := getStats(hit.thrower); s != nil {
+				s.FlashesLeadingToKills++
+			}
+		}
+
+		// --- CLUTCH LOGIC ---
+		// Check the victim's team. If they dropped to 1 alive, that last guy is now clutching.
+		// Important: This logic triggers only on the timestamp the death happened.
+		// It doesn't handle if multiple people disconnect, but for demos it's fine.
+
+		victimTeam := e.Victim.Team
+		// Get alive members of that team AFTER this death
+		// The event happens before the state update fully propagates in some parsers,
+		// but usually IsAlive() on the victim is still true?
+		// Actually best to count manually or iterate participants
+
+		teamMembers := p.GameState().Team(victimTeam).Members()
+		aliveCount := 0
+		var lastSurvivor *common.Player
+
+		for _, m := range teamMembers {
+			// e.Victim is the one dying, so ignore him even if currently marked alive
+			if m.IsAlive() && m.SteamID64 != e.Victim.SteamID64 {
+				aliveCount++
+				lastSurvivor = m
+			}
+		}
+
+		if aliveCount == 1 && lastSurvivor != nil {
+			// A clutch situation has begun for lastSurvivor
+			if s := getStats(lastSurvivor); s != nil {
+				s.TimesLastAlive++
+			}
+
+			// Count enemies
+			enemyTeam := common.TeamCounterTerrorists
+			if victimTeam == common.TeamCounterTerrorists {
+				enemyTeam = common.TeamTerrorists
+			}
+
+			enemies := 0
+			for _, m := range p.GameState().Team(enemyTeam).Members() {
+				if m.IsAlive() {
+					enemies++
+				}
+			}
+			clutches[victimTeam] = clutch{player: lastSurvivor, opponents: enemies}
+		} else if aliveCount == 0 {
+			// Team wiped, clutch failed if it was pending for this team
+			if c, ok := clutches[victimTeam]; ok {
+				if s := getStats(c.player); s != nil && c.opponents >= 1 {
+					s.ClutchLosses[c.opponents]++
+				}
+				delete(clutches, victimTeam) // Failed
+			}
+		}
+
+		// 1v1: one left on each side after this death
+		if oneVOne == nil {
+			var alive []*common.Player
+			for _, m := range p.GameState().Participants().Playing() {
+				if m.IsAlive() && m.SteamID64 != e.Victim.SteamID64 {
+					alive = append(alive, m)
+				}
+			}
+			if len(alive) == 2 && alive[0].Team != alive[1].Team {
+				oneVOne = alive
+			}
+		}
+	})
+
+	p.RegisterEventHandler(func(e events.PlayerHurt) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		if s := getStats(e.Player); s != nil {
+			s.DamageTaken += e.HealthDamage
+		}
+
+		// Self-inflicted or world damage doesn't count towards Damage/ADR
+		if e.Attacker == nil || (e.Player != nil && e.Attacker.SteamID64 == e.Player.SteamID64) {
+			s := getStats(e.Player)
+			if s != nil {
+				s.SelfDamage += e.HealthDamage
+			}
+			return
+		}
+		// Friendly fire is tracked on its own so it doesn't inflate ADR
+		if e.Player != nil && e.Attacker.Team == e.Player.Team {
+			if s := getStats(e.Attacker); s != nil {
+				s.TeamDamage += e.HealthDamage
+			}
+			return
+		}
+		if e.Attacker != nil {
+			s := getStats(e.Attacker)
+			if s != nil {
+				s.Damage += e.HealthDamage
+				roundDamage[e.Attacker.SteamID64] += e.HealthDamage
+				tick := p.GameState().IngameTick()
+				if last, ok := lastHitTick[e.Attacker.SteamID64]; e.Weapon != nil && isGun(e.Weapon) && (!ok || last != tick) {
+					lastHitTick[e.Attacker.SteamID64] = tick
+					ws := s.WeaponStats[weaponName(e.Weapon)]
+					ws.ShotsHit++
+					s.WeaponStats[weaponName(e.Weapon)] = ws
+				}
+
+				if e.Player != nil {
+					if roundVictims[e.Attacker.SteamID64] == nil {
+						roundVictims[e.Attacker.SteamID64] = make(map[uint64]bool)
+					}
+					roundVictims[e.Attacker.SteamID64][e.Player.SteamID64] = true
+
+					key := lifeDamageKey{e.Player.SteamID64, e.Attacker.SteamID64}
+					if e.Attacker.Entity != nil && e.Attacker.IsBlinded() {
+						blindDamage[key] = true
+					}
+					for _, smoke := range activeSmokes {
+						if segmentNear(e.Attacker.Position(), e.Player.Position(), smoke, smokeRadius) {
+							smokeDamage[key] = true
+							break
+						}
+					}
+				}
+
+				if opts.WeaponByDamage && e.Player != nil && e.Weapon != nil {
+					key := lifeDamageKey{e.Player.SteamID64, e.Attacker.SteamID64}
+					if lifeDamage[key] == nil {
+						lifeDamage[key] = make(map[string]int)
+					}
+					lifeDamage[key][weaponName(e.Weapon)] += e.HealthDamage
+				}
+
+				// Utility Damage
+				if opts.Groups["grenades"] && e.Weapon != nil {
+					switch e.Weapon.Type {
+					case common.EqHE:
+						s.HEDamage += e.HealthDamage
+						s.UtilityDamage += e.HealthDamage
+					case common.EqMolotov, common.EqIncendiary:
+						s.FireDamage += e.HealthDamage
+						s.UtilityDamage += e.HealthDamage
+						if e.Player != nil {
+							key := lifeDamageKey{e.Player.SteamID64, e.Attacker.SteamID64}
+							if last, ok := fireContact[key]; ok && p.CurrentTime()-last <= fireTickGap {
+								s.InfernoTickDamage += e.HealthDamage
+							}
+							fireContact[key] = p.CurrentTime()
+						}
+					}
+				}
+			}
+		}
+	})
+
+	p.RegisterEventHandler(func(e events.WeaponFire) {
+		if !p.GameState().IsMatchStarted() || e.Weapon == nil || !isGun(e.Weapon) {
+			return
+		}
+		if s := getStats(e.Shooter); s != nil {
+			ws := s.WeaponStats[weaponName(e.Weapon)]
+			ws.ShotsFired++
+			s.WeaponStats[weaponName(e.Weapon)] = ws
+		}
+	})
+
+	if opts.Groups["grenades"] {
+		p.RegisterEventHandler(func(e events.PlayerFlashed) {
+			if !p.GameState().IsMatchStarted() {
+				return
+			}
+			// PlayerFlashed: e.Player (victim), e.Attacker (thrower)
+			if e.Attacker != nil && e.Player != nil && e.Attacker.Team != e.Player.Team {
+				s := getStats(e.Attacker)
+				if s != nil {
+					s.Flashed++
+					s.EnemyBlindTime += e.FlashDuration().Seconds()
+				}
+				key := flashKey{e.Attacker.SteamID64, p.GameState().IngameTick()}
+				lastFlash[e.Player.SteamID64] = flashHit{key: key, thrower: e.Attacker, at: p.CurrentTime()}
+			} else if e.Attacker != nil && e.Player != nil && e.Attacker.Team == e.Player.Team {
+				// Team flash
+				s := getStats(e.Attacker)
+				if s != nil {
+					s.TeamFlashed++
+				}
+			}
+		})
+	}
+
+	p.RegisterEventHandler(func(e events.SmokeStart) {
+		activeSmokes[e.GrenadeEntityID] = e.Position
+	})
+	p.RegisterEventHandler(func(e events.SmokeExpired) {
+		delete(activeSmokes, e.GrenadeEntityID)
+	})
+
+	p.RegisterEventHandler(func(e events.BombPlanted) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		bombPlantTime = p.CurrentTime()
+		bombPlanter = e.Player
+		s := getStats(e.Player)
+		if s != nil {
+			s.BombPlants++
+			roundImpact[e.Player.SteamID64]++
+			s.PlantedRounds = append(s.PlantedRounds, totalRounds+1)
+		}
+	})
+
+	p.RegisterEventHandler(func(e events.BombPickup) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		s := getStats(e.Player)
+		if s != nil {
+			s.BombPickups++
+		}
+	})
+
+	p.RegisterEventHandler(func(e events.BombDropped) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		s := getStats(e.Player)
+		if s != nil {
+			s.BombDrops++
+		}
+	})
+
+	p.RegisterEventHandler(func(e events.BombDefused) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		s := getStats(e.Player)
+		if s != nil {
+			s.BombDefuses++
+			roundImpact[e.Player.SteamID64]++
+		}
+	})
+
+	// Round summaries, for -rounds and onRound
+	summarize := opts.Rounds || onRound != nil
+
+	// Bomb timeline for the round summary
+	if summarize {
+		sinceLive := func() float64 { return opts.round((p.CurrentTime() - roundLiveTime).Seconds(), 1) }
+		p.RegisterEventHandler(func(e events.BombPlanted) {
+			if p.GameState().IsMatchStarted() {
+				roundBomb = &RoundBomb{PlantTime: sinceLive(), DefuseStarts: []float64{}}
+				if e.Site != events.BomsiteUnknown {
+					roundBomb.Site = string(e.Site)
+				}
+			}
+		})
+		p.RegisterEventHandler(func(e events.BombDefuseStart) {
+			if roundBomb != nil {
+				roundBomb.DefuseStarts = append(roundBomb.DefuseStarts, sinceLive())
+			}
+		})
+		p.RegisterEventHandler(func(e events.BombDefuseAborted) {
+			if roundBomb != nil {
+				roundBomb.DefuseInterrupted = true
+			}
+		})
+		p.RegisterEventHandler(func(e events.BombDefused) {
+			if roundBomb != nil {
+				roundBomb.Outcome = "defused"
+				roundBomb.DefuseTime = sinceLive()
+			}
+		})
+		p.RegisterEventHandler(func(e events.BombExplode) {
+			if roundBomb != nil {
+				roundBomb.Outcome = "exploded"
+			}
+		})
+	}
+
+	// Grenades thrown and what they cost
+	if opts.Groups["grenades"] {
+		p.RegisterEventHandler(func(e events.GrenadeProjectileThrow) {
+			if !p.GameState().IsMatchStarted() || e.Projectile == nil || e.Projectile.WeaponInstance == nil {
+				return
+			}
+			s := getStats(e.Projectile.Thrower)
+			if s != nil {
+				nade := e.Projectile.WeaponInstance.Type
+				s.GrenadesThrown[nade.String()]++
+				s.UtilityValueSpent += grenadePrices[nade]
+				switch nade {
+				case common.EqSmoke:
+					s.SmokesThrown++
+				case common.EqMolotov, common.EqIncendiary:
+					s.MolotovsThrown++
+				}
+			}
+		})
+
+		// Area denial: how long each thrower's infernos burned
+		type activeInferno struct {
+			thrower *common.Player
+			start   time.Duration
+		}
+		infernos := make(map[int64]activeInferno)
+		p.RegisterEventHandler(func(e events.InfernoStart) {
+			if !p.GameState().IsMatchStarted() || e.Inferno == nil {
+				return
+			}
+			infernos[e.Inferno.UniqueID()] = activeInferno{thrower: e.Inferno.Thrower(), start: p.CurrentTime()}
+		})
+		p.RegisterEventHandler(func(e events.InfernoExpired) {
+			if e.Inferno == nil {
+				return
+			}
+			inf, ok := infernos[e.Inferno.UniqueID()]
+			if !ok {
+				return
+			}
+			delete(infernos, e.Inferno.UniqueID())
+			if s := getStats(inf.thrower); s != nil {
+				s.FireAreaDenialTime += (p.CurrentTime() - inf.start).Seconds()
+			}
+		})
+	}
+
+	// Grenade detonations bucketed on a grid, see nadeSpotGrid
+	type nadeBucket struct {
+		nade    string
+		x, y, z int
+	}
+	type nadeCluster struct {
+		x, y, z float64 // Position sums
+		count   int
+	}
+	nadeClusters := make(map[nadeBucket]*nadeCluster)
+	if opts.NadeSpots && opts.Groups["grenades"] && opts.Groups["positions"] {
+		p.RegisterEventHandler(func(e events.GrenadeProjectileDestroy) {
+			if !p.GameState().IsMatchStarted() || e.Projectile == nil || e.Projectile.WeaponInstance == nil {
+				return
+			}
+			pos := e.Projectile.Position()
+			bucket := nadeBucket{
+				nade: e.Projectile.WeaponInstance.Type.String(),
+				x:    int(math.Floor(pos.X / nadeSpotGrid)),
+				y:    int(math.Floor(pos.Y / nadeSpotGrid)),
+				z:    int(math.Floor(pos.Z / nadeSpotGrid)),
+			}
+			c := nadeClusters[bucket]
+			if c == nil {
+				c = &nadeCluster{}
+				nadeClusters[bucket] = c
+			}
+			c.x += pos.X
+			c.y += pos.Y
+			c.z += pos.Z
+			c.count++
+		})
+	}
+
+	// Rank as of this match, from the end-of-match rank updates
+	rankUpdates := make(map[uint64]int)
+	p.RegisterEventHandler(func(e events.RankUpdate) {
+		if e.RankOld > 0 {
+			rankUpdates[e.SteamID64()] = e.RankOld
+		}
+	})
+
+	// Spotting: count each time a player newly spots an enemy.
+	// Opt-in since spotter changes fire very often.
+	if opts.TrackSpotting {
+		spottedBy := make(map[uint64]map[uint64]bool) // Spotted -> spotters
+		p.RegisterEventHandler(func(e events.RoundStart) {
+			spottedBy = make(map[uint64]map[uint64]bool)
+		})
+		p.RegisterEventHandler(func(e events.PlayerSpottersChanged) {
+			if !p.GameState().IsMatchStarted() || e.Spotted == nil || !e.Spotted.IsAlive() {
+				return
+			}
+			prev := spottedBy[e.Spotted.SteamID64]
+			cur := make(map[uint64]bool)
+			for _, other := range p.GameState().Participants().Playing() {
+				if other.Team == e.Spotted.Team || !other.IsAlive() {
+					continue
+				}
+				if e.Spotted.IsSpottedBy(other) {
+					cur[other.SteamID64] = true
+					if !prev[other.SteamID64] {
+						if s := getStats(other); s != nil {
+							s.EnemiesSpotted++
+						}
+					}
+				}
+			}
+			spottedBy[e.Spotted.SteamID64] = cur
+		})
+	}
+
+	// Match Start / Round tracking for ADR
+	p.RegisterEventHandler(func(e events.RoundEnd) {
+		if !p.GameState().IsMatchStarted() {
+			return
+		}
+		totalRounds++
+
+		// Process Multi-Kills
+		for steamID, kills := range roundKills {
+			if kills > 0 {
+				s := stats[steamID]
+				if s != nil {
+					s.MultiKills[kills]++
+					if kills >= opts.MinMultiKill {
+						s.MultiKillRounds = append(s.MultiKillRounds, MultiKillRound{Round: totalRounds, Kills: kills})
+					}
+				}
+			}
+		}
+
+		// Process buy type outcomes
+		for team, bt := range roundBuyType {
+			for _, m := range p.GameState().Team(team).Members() {
+				if _, ok := stats[m.SteamID64]; ok {
+					buyRounds = append(buyRounds, buyRound{player: m.SteamID64, buyType: bt, won: team == e.Winner})
+					break
+				}
+			}
+		}
+
+		// Process loss bonus: the streaks are the state going into this round
+		tStreak, ctStreak := lossStreak[common.TeamTerrorists], lossStreak[common.TeamCounterTerrorists]
+		if opts.Groups["economy"] && e.LoserState != nil {
+			for team, totals := range lossBonus {
+				if lossStreak[team] > 0 {
+					totals.BonusRounds++
+				}
+				if lossStreak[team] >= maxLossStreak {
+					totals.MaxBonusRounds++
+				}
+			}
+			lossStreak[e.Winner] = 0
+			lossStreak[e.LoserState.Team()]++
+			loser := lossBonus[e.LoserState.Team()]
+			if loser != nil {
+				loser.LongestLossStreak = max(loser.LongestLossStreak, lossStreak[e.LoserState.Team()])
+			}
+		}
+
+		// Process spray transfers / crossfires
+		for steamID, victims := range roundVictims {
+			if s := stats[steamID]; s != nil && len(victims) >= 2 {
+				s.MultiTargetRounds++
+			}
+		}
+
+		// Process KAST (kill, assist, survived or traded) and money spent
+		for _, pl := range p.GameState().Participants().Playing() {
+			id := pl.SteamID64
+			if !roundSpawned[id] && !roundDied[id] {
+				continue // Coach or spectator
+			}
+			s := getStats(pl)
+			if s == nil {
+				continue
+			}
+			s.RoundsPlayed++
+			s.TotalSpent += pl.MoneySpentThisRound()
+			aliveUntil := p.CurrentTime()
+			if roundDied[id] {
+				aliveUntil = roundDeathTime[id]
+			}
+			s.TimeAliveTotal += max(aliveUntil-roundLiveTime, 0).Seconds()
+			if roundKills[id] > 0 || roundAssisted[id] || !roundDied[id] || roundTraded[id] {
+				s.KASTRounds++
+			}
+		}
+
+		// Process Saves
+		if opts.Groups["economy"] && e.LoserState != nil {
+			for _, pl := range p.GameState().Participants().Playing() {
+				if pl.Team == e.LoserState.Team() && pl.IsAlive() && pl.EquipmentValueCurrent() >= minSaveEquipmentValue {
+					if s := getStats(pl); s != nil {
+						s.Saves++
+					}
+				}
+			}
+		}
+
+		// Process Clutch, for each team that had one still pending
+		for team, c := range clutches {
+			// Validate: Clutches are usually 1v1, 1v2 etc.
+			// If c.opponents >= 1, it's a clutch
+			if c.opponents < 1 {
+				continue
+			}
+			s := getStats(c.player)
+			if s == nil {
+				continue
+			}
+			if team == e.Winner {
+				s.ClutchWins++
+				roundImpact[c.player.SteamID64] += clutchImpact
+			} else {
+				// Survived but lost anyway: time ran out, the bomb went off,
+				// or the other team's last man won their own clutch
+				s.ClutchLosses[c.opponents]++
+			}
+		}
+
+		if bombPlantTime > 0 {
+			plantRounds.Plants++
+			switch e.Winner {
+			case common.TeamTerrorists:
+				plantRounds.PostPlantWins++
+			case common.TeamCounterTerrorists:
+				plantRounds.Retakes++
+			}
+		}
+
+		for _, pl := range oneVOne {
+			if s := getStats(pl); s != nil {
+				if pl.Team == e.Winner {
+					s.OneVOneWins++
+				} else {
+					s.OneVOneLosses++
+				}
+			}
+		}
+
+		if summarize {
+			summary := RoundSummary{
+				Round:        totalRounds,
+				Phase:        roundPhase(totalRounds, p.GameState().Rules().ConVars()),
+				Winner:       int(e.Winner),
+				Survivors:    []RoundSurvivor{},
+				TLossStreak:  tStreak,
+				CTLossStreak: ctStreak,
+				Bomb:         roundBomb,
+			}
+			for _, pl := range p.GameState().Participants().Playing() {
+				if pl.IsAlive() {
+					summary.Survivors = append(summary.Survivors, RoundSurvivor{
+						Player:  pl.Name,
+						SteamID: pl.SteamID64,
+						TeamNum: int(pl.Team),
+						HP:      pl.Health(),
+					})
+				}
+			}
+			// Playing() comes from a map, keep the output stable
+			sort.Slice(summary.Survivors, func(i, j int) bool {
+				return summary.Survivors[i].SteamID < summary.Survivors[j].SteamID
+			})
+
+			// Round MVP: most kills + traded entry deaths + clutch + plant/defuse
+			for id, kills := range roundKills {
+				roundImpact[id] += kills
+			}
+			best := 0
+			for id, impact := range roundImpact {
+				if impact > best || (impact == best && id < summary.RoundMVP) {
+					best, summary.RoundMVP = impact, id
+				}
+			}
+			if opts.Rounds {
+				roundSummaries = append(roundSummaries, summary)
+			}
+			if onRound != nil {
+				onRound(summary)
+			}
+		}
+
+		kills := 0
+		for _, k := range roundKills {
+			kills += k
+		}
+		Debug.Printf("round %d ended at tick %d: winner %d, %d kills, %d players tracked", totalRounds, p.GameState().IngameTick(), e.Winner, kills, len(stats))
+	})
+
+	// Parse frame by frame so a demo that breaks off mid-match (truncated
+	// download, crashed server) still yields the rounds played so far
+	for {
+		more, err := parseNextFrame(p)
+		if err != nil {
+			if totalRounds == 0 {
+				return MatchResult{}, err
+			}
+			warnings = append(warnings, fmt.Sprintf("demo is incomplete, stats only cover the first %d rounds: %v", totalRounds, err))
+			break
+		}
+		if !more {
+			break
+		}
+		if opts.MaxRound > 0 && totalRounds >= opts.MaxRound {
+			warnings = append(warnings, fmt.Sprintf("stopped after round %d (-max-round), stats are partial", totalRounds))
+			break
+		}
+	}
+
+	// Finalizing Data
+	gameState := p.GameState()
+	tTeam := gameState.Team(common.TeamTerrorists)
+	ctTeam := gameState.Team(common.TeamCounterTerrorists)
+
+	if tTeam != nil {
+		scoreT = tTeam.Score()
+	}
+	if ctTeam != nil {
+		scoreCT = ctTeam.Score()
+	}
+
+	scoreStr := fmt.Sprintf("T %d - %d CT", scoreT, scoreCT)
+
+	// Check header for map
+	header := p.Header()
+	mapName := displayMapName(header.MapName)
+
+	// Full match = both halves added together
+	secondHalfStats := stats
+	if firstHalfStats != nil {
+		stats = make(map[uint64]*PlayerStats)
+		for _, half := range []map[uint64]*PlayerStats{firstHalfStats, secondHalfStats} {
+			for id, hs := range half {
+				if _, ok := stats[id]; !ok {
+					stats[id] = &PlayerStats{}
+				}
+				AddStats(stats[id], hs)
+			}
+		}
+	}
+
+	// Capture the in-game Score from Participants at end of demo.
+	// Only fill rows we already track: going through getStats here would
+	// overwrite TeamNum with the post-swap team and add rows for people
+	// who never played.
+	for _, participant := range gameState.Participants().All() {
+		if s, ok := stats[participant.SteamID64]; ok {
+			s.Score = participant.Score()
+			if participant.Entity != nil {
+				s.Rank = participant.Rank()
+			}
+		}
+	}
+	// Matchmaking demos announce ranks at the end, use them where the
+	// player resource had none (e.g. the player already left)
+	for id, rank := range rankUpdates {
+		if s, ok := stats[id]; ok && s.Rank == 0 {
+			s.Rank = rank
+		}
+	}
+
+	// Mark who's still there at the end (subs who left are not)
+	for _, participant := range gameState.Participants().Connected() {
+		for _, m := range []map[uint64]*PlayerStats{stats, firstHalfStats, secondHalfStats} {
+			if s, ok := m[participant.SteamID64]; ok {
+				s.Connected = true
+			}
+		}
+	}
+
+	// A competitive match has exactly 10 humans, anything else is probably
+	// deathmatch, retakes or a match with subs, so team stats are suspect
+	bots := make(map[uint64]bool)
+	for _, participant := range gameState.Participants().All() {
+		if participant.IsBot {
+			bots[participant.SteamID64] = true
+		}
+	}
+	// Coaches sit on a team too, but never play a round
+	playerCount := 0
+	for id, s := range stats {
+		if id != 0 && !bots[id] && s.RoundsPlayed > 0 && (s.TeamNum == int(common.TeamTerrorists) || s.TeamNum == int(common.TeamCounterTerrorists)) {
+			playerCount++
+		}
+	}
+	if totalRounds == 0 {
+		// Every handler waits for IsMatchStarted, so a warmup-only or
+		// aborted demo would otherwise look like a real 0-0 match
+		warnings = append(warnings, "no live match detected, the demo only contains warmup or was aborted")
+	} else if playerCount != 10 {
+		warnings = append(warnings, fmt.Sprintf("expected 10 players in a competitive match, found %d", playerCount))
+	}
+
+	statsList := FinalizeStats(stats, totalRounds, opts)
+
+	var avgFirstContact float64
+	if firstContactRounds > 0 {
+		avgFirstContact = opts.round(firstContactTotal/float64(firstContactRounds), 1)
+	}
+	plantRounds.PostPlantWinRate = opts.round(winRate(plantRounds.PostPlantWins, plantRounds.Plants-plantRounds.PostPlantWins), 1)
+	plantRounds.RetakeRate = opts.round(winRate(plantRounds.Retakes, plantRounds.Plants-plantRounds.Retakes), 1)
+	sideFirstContact := func(team common.Team) float64 {
+		if sideFirstContactRounds[team] == 0 {
+			return 0
+		}
+		return opts.round(sideFirstContactTotal[team]/float64(sideFirstContactRounds[team]), 1)
+	}
+
+	logTeams("demo end")
+	eventTypes := make([]string, 0, len(eventCounts))
+	for t := range eventCounts {
+		eventTypes = append(eventTypes, t)
+	}
+	sort.Strings(eventTypes)
+	for _, t := range eventTypes {
+		Debug.Printf("%s fired %d times", t, eventCounts[t])
+	}
+
+	// Non-standard servers change these, timing stats above already use them
+	rules := p.GameState().Rules()
+	ruleSeconds := func(get func() (time.Duration, error)) float64 {
+		d, err := get()
+		if err != nil {
+			return 0
+		}
+		return d.Seconds()
+	}
+
+	var commonNadeSpots []NadeSpot
+	for bucket, c := range nadeClusters {
+		if c.count < 2 {
+			continue
+		}
+		n := float64(c.count)
+		commonNadeSpots = append(commonNadeSpots, NadeSpot{
+			Type:  bucket.nade,
+			X:     opts.round(c.x/n, 1),
+			Y:     opts.round(c.y/n, 1),
+			Z:     opts.round(c.z/n, 1),
+			Count: c.count,
+		})
+	}
+	sort.Slice(commonNadeSpots, func(i, j int) bool {
+		a, b := commonNadeSpots[i], commonNadeSpots[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.X != b.X {
+			return a.X < b.X
+		}
+		return a.Y < b.Y
+	})
+	if len(commonNadeSpots) > maxNadeSpots {
+		commonNadeSpots = commonNadeSpots[:maxNadeSpots]
+	}
+
+	// Rounds won and lost per team and buy type
+	type buyRecord struct{ wins, losses int }
+	buyRecords := make(map[int]map[string]*buyRecord)
+	for _, r := range buyRounds {
+		team := stats[r.player].TeamNum
+		if buyRecords[team] == nil {
+			buyRecords[team] = make(map[string]*buyRecord)
+		}
+		rec := buyRecords[team][r.buyType]
+		if rec == nil {
+			rec = &buyRecord{}
+			buyRecords[team][r.buyType] = rec
+		}
+		if r.won {
+			rec.wins++
+		} else {
+			rec.losses++
+		}
+	}
+	var buyTypeWinRates []BuyTypeWinRate
+	for _, team := range []int{int(common.TeamTerrorists), int(common.TeamCounterTerrorists)} {
+		for _, bt := range []string{"eco", "force", "full"} {
+			if rec := buyRecords[team][bt]; rec != nil {
+				buyTypeWinRates = append(buyTypeWinRates, BuyTypeWinRate{
+					TeamNum: team,
+					BuyType: bt,
+					Rounds:  rec.wins + rec.losses,
+					WinRate: opts.round(winRate(rec.wins, rec.losses), 1),
+				})
+			}
+		}
+	}
+
+	var lossBonusTotals []LossBonusTotals
+	if opts.Groups["economy"] && totalRounds > 0 {
+		lossBonusTotals = []LossBonusTotals{*lossBonus[common.TeamTerrorists], *lossBonus[common.TeamCounterTerrorists]}
+	}
+
+	var statsFirstHalf, statsSecondHalf []PlayerStats
+	if firstHalfStats != nil {
+		statsFirstHalf = FinalizeStats(firstHalfStats, firstHalfRounds, opts)
+		statsSecondHalf = FinalizeStats(secondHalfStats, totalRounds-firstHalfRounds, opts)
+	}
+
+	teams := teamTotals(stats, totalRounds, opts)
+	teams[0].Score, teams[1].Score = scoreT, scoreCT
+	teams[0].Won, teams[1].Won = scoreT > scoreCT, scoreCT > scoreT
+	if opts.TeamsOnly {
+		return MatchResult{
+			SchemaVersion: SchemaVersion,
+			ScoreStr:      scoreStr,
+			Teams:         teams,
+			MapName:       mapName,
+			ScoreT:        scoreT,
+			ScoreCT:       scoreCT,
+			Warnings:      warnings,
+			teamsOnly:     true,
+		}, nil
+	}
+
+	return MatchResult{
+		SchemaVersion:         SchemaVersion,
+		ScoreStr:              scoreStr,
+		Stats:                 statsList,
+		Teams:                 teams,
+		MapName:               mapName,
+		ScoreT:                scoreT,
+		ScoreCT:               scoreCT,
+		EconomyTimeline:       economyTimeline,
+		AvgFirstContactTime:   avgFirstContact,
+		AvgTSideFirstContact:  sideFirstContact(common.TeamTerrorists),
+		AvgCTSideFirstContact: sideFirstContact(common.TeamCounterTerrorists),
+		RoundTime:             ruleSeconds(rules.RoundTime),
+		FreezeTime:            ruleSeconds(rules.FreezeTime),
+		BombTime:              ruleSeconds(rules.BombTime),
+		StatsFirstHalf:        statsFirstHalf,
+		StatsSecondHalf:       statsSecondHalf,
+		Killfeed:              killfeed,
+		Rounds:                roundSummaries,
+		LossBonus:             lossBonusTotals,
+		CommonNadeSpots:       commonNadeSpots,
+		OpeningDuels:          openingDuels,
+		BuyTypeWinRates:       buyTypeWinRates,
+		PlantRounds:           plantRounds,
+		PlayerCount:           playerCount,
+		Warnings:              warnings,
+	}, nil
+}
+
+// newParser is demoinfocs.NewParser, which panics on inputs too short to
+// even hold a header
+func newParser(r io.Reader) (p demoinfocs.Parser, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("parser panicked: %v", rec)
+		}
+	}()
+	return demoinfocs.NewParser(r), nil
+}
+
+// parseNextFrame is p.ParseNextFrame, but a panic in the library or one of
+// our handlers (malformed demos do that) becomes an error so the stats
+// gathered so far can still be reported.
+func parseNextFrame(p demoinfocs.Parser) (more bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			buf := make([]byte, 64<<10)
+			Debug.Printf("parser panic: %v\n%s", r, buf[:runtime.Stack(buf, false)])
+			more, err = false, fmt.Errorf("parser panicked: %v", r)
+		}
+	}()
+	return p.ParseNextFrame()
+}
+
+// parseHeader is p.ParseHeader, which panics when the file ends inside the
+// header
+func parseHeader(p demoinfocs.Parser) (h common.DemoHeader, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("parser panicked: %v", rec)
+		}
+	}()
+	return p.ParseHeader()
+}
+
+// parseToEnd is p.ParseToEnd with parseNextFrame's panic handling
+func parseToEnd(p demoinfocs.Parser) error {
+	for {
+		more, err := parseNextFrame(p)
+		if err != nil || !more {
+			return err
+		}
+	}
+}
+
+// displayMapName turns "de_mirage" into "Mirage", other prefixes are kept
+func displayMapName(name string) string {
+	if strings.HasPrefix(name, "de_") {
+		return strings.Title(name[3:])
+	}
+	return name
+}
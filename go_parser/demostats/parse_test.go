@@ -0,0 +1,135 @@
+package demostats
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// csgoHeader is testdata/header.dem, a well-formed CS:GO demo header for
+// de_test (60s, 64 tick) with nothing after it, 1072 bytes
+func csgoHeader(t *testing.T) []byte {
+	t.Helper()
+	b, err := os.ReadFile("testdata/header.dem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// Garbage and cut-off demos come back as an error, never a panic
+func TestParseBrokenDemos(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"garbage", bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 500)},
+		{"magic only", []byte("HL2DEMO\x00")},
+		{"half a header", csgoHeader(t)[:500]},
+		{"header then zeros", append(csgoHeader(t), make([]byte, 2000)...)},
+		{"header then garbage", append(csgoHeader(t), bytes.Repeat([]byte{0xff, 0x01, 0x7f}, 700)...)},
+	}
+	for _, tt := range tests {
+		for _, onlyMaps := range []bool{false, true} {
+			opts := DefaultOptions()
+			if onlyMaps {
+				opts.OnlyMaps = map[string]bool{"de_test": true}
+			}
+			if _, err := Parse(bytes.NewReader(tt.data), opts, nil); err == nil {
+				t.Errorf("%s (only-maps %v): got no error", tt.name, onlyMaps)
+			}
+		}
+	}
+}
+
+// OnlyMaps stops at the header of a demo on another map
+func TestParseOnlyMapsSkips(t *testing.T) {
+	opts := DefaultOptions()
+	opts.OnlyMaps = map[string]bool{"de_dust2": true}
+	result, err := Parse(bytes.NewReader(csgoHeader(t)), opts, nil)
+	if err != nil || !result.Skipped || result.MapName != displayMapName("de_test") {
+		t.Errorf("got Skipped %v, err %v, MapName %q, want a skipped de_test", result.Skipped, err, result.MapName)
+	}
+}
+
+func TestReadHeaderBrokenDemos(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"empty":   nil,
+		"magic":   []byte("HL2DEMO\x00"),
+		"half":    csgoHeader(t)[:500],
+		"garbage": bytes.Repeat([]byte{0x42}, 2000),
+	} {
+		if _, err := ReadHeader(bytes.NewReader(data)); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+
+	result, err := ReadHeader(bytes.NewReader(csgoHeader(t)))
+	if err != nil {
+		t.Fatalf("valid header: %v", err)
+	}
+	if result.MapName != "de_test" || result.TickRate != 64 {
+		t.Errorf("MapName %q, TickRate %v, want de_test, 64", result.MapName, result.TickRate)
+	}
+}
+
+// A panic part way through keeps the rounds parsed before it
+func TestParsePanicKeepsPartialStats(t *testing.T) {
+	d := newFakeDemo()
+	tPlayer := d.addPlayer(1, "t", common.TeamTerrorists)
+	ct := d.addPlayer(2, "ct", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.kill(tPlayer, ct, common.EqAK47)
+	})
+	d.frame(func() {
+		d.dispatch(events.RoundStart{})
+		panic("corrupt entity update")
+	})
+
+	result := d.parse(t, DefaultOptions())
+	if s := statsOf(t, result, 1); s.Kills != 1 {
+		t.Errorf("Kills = %d, want the round before the panic counted", s.Kills)
+	}
+	found := false
+	for _, w := range result.Warnings {
+		found = found || strings.Contains(w, "corrupt entity update")
+	}
+	if !found {
+		t.Errorf("Warnings = %q, want the panic mentioned", result.Warnings)
+	}
+}
+
+// onRound sees each round's summary as it ends, without Rounds in the
+// result unless asked for
+func TestParseOnRound(t *testing.T) {
+	d := newFakeDemo()
+	tPlayer := d.addPlayer(1, "t", common.TeamTerrorists)
+	ct := d.addPlayer(2, "ct", common.TeamCounterTerrorists)
+	d.startMatch()
+	d.round(common.TeamTerrorists, func() {
+		d.kill(tPlayer, ct, common.EqAK47)
+	})
+	d.round(common.TeamCounterTerrorists, func() {
+		d.kill(ct, tPlayer, common.EqM4A4)
+	})
+
+	var got []RoundSummary
+	result, err := parseMatch(d, DefaultOptions(), func(r RoundSummary) {
+		got = append(got, r)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Winner != int(common.TeamTerrorists) || got[1].Winner != int(common.TeamCounterTerrorists) {
+		t.Fatalf("got %+v, want a T then a CT round", got)
+	}
+	if result.Rounds != nil {
+		t.Errorf("Rounds = %+v, want none without Options.Rounds", result.Rounds)
+	}
+}
@@ -0,0 +1,333 @@
+package demostats
+
+import "encoding/json"
+
+// PlayerStats holds the aggregated stats for a player
+type PlayerStats struct {
+	Player                string                `json:"Player"`
+	AllNames              []string              `json:"AllNames"` // Every distinct name seen, in order
+	SteamID               uint64                `json:"SteamID"`
+	TeamNum               int                   `json:"TeamNum"`
+	Connected             bool                  `json:"Connected"` // Still connected at demo end
+	Kills                 int                   `json:"Kills"`
+	Deaths                int                   `json:"Deaths"`
+	Assists               int                   `json:"Assists"`
+	KD                    float64               `json:"K/D"`
+	ADR                   float64               `json:"ADR"`
+	HSPercent             float64               `json:"HS%"`
+	Rating                float64               `json:"Rating"` // HLTV 1.0 rating
+	Score                 int                   `json:"Score"`
+	Rank                  int                   `json:"Rank"`              // Competitive rank/rating if the demo has it, 0 = unknown
+	Matches               int                   `json:"Matches,omitempty"` // Demos merged into this row, only with -aggregate
+	Damage                int                   `json:"Damage"`
+	UtilityDamage         int                   `json:"UtilityDamage"`
+	UtilityADR            float64               `json:"UtilityADR"` // UtilityDamage per round
+	HEDamage              int                   `json:"HEDamage"`
+	FireDamage            int                   `json:"FireDamage"`        // Molotov + incendiary
+	InfernoTickDamage     int                   `json:"InfernoTickDamage"` // Part of FireDamage taken while standing in the fire
+	SelfDamage            int                   `json:"SelfDamage"`        // Falling, own nades, bomb
+	DamageTaken           int                   `json:"DamageTaken"`
+	TeamDamage            int                   `json:"TeamDamage"`        // Friendly fire, not part of Damage/ADR
+	GrenadesThrown        map[string]int        `json:"GrenadesThrown"`    // Per grenade type
+	UtilityValueSpent     int                   `json:"UtilityValueSpent"` // Cost of grenades thrown
+	UtilityPerRound       float64               `json:"UtilityPerRound"`   // Grenades thrown per round played
+	SmokesThrown          int                   `json:"SmokesThrown"`
+	SmokeKills            int                   `json:"SmokeKills"`            // Kills through a smoke
+	OneWayKills           int                   `json:"OneWayKills"`           // Smoke kills from outside the smoke on a victim inside it (best effort)
+	MolotovsThrown        int                   `json:"MolotovsThrown"`        // Molotov + incendiary
+	FireAreaDenialTime    float64               `json:"FireAreaDenialTime"`    // Seconds this player's fires burned
+	Flashed               int                   `json:"Flashed"`               // Number of enemies flashed
+	TeamFlashed           int                   `json:"TeamFlashed"`           // Number of teammates flashed
+	FlashEfficiency       float64               `json:"FlashEfficiency"`       // Enemies flashed per flashbang thrown
+	FlashesLeadingToKills int                   `json:"FlashesLeadingToKills"` // Flashes whose blinded enemy the thrower's team killed within flashKillWindow
+	AvgEnemyBlindPerFlash float64               `json:"AvgEnemyBlindPerFlash"` // Seconds of enemy blindness per flashbang thrown
+	FlashAssists          int                   `json:"FlashAssists"`
+	DamageAssists         int                   `json:"DamageAssists"` // Assists = DamageAssists + FlashAssists
+	SmokeAssists          int                   `json:"SmokeAssists"`  // Damage assists with some of the damage dealt through a smoke
+	BlindAssists          int                   `json:"BlindAssists"`  // Damage assists with some of the damage dealt while flashed
+	TotalSpent            int                   `json:"TotalSpent"`
+	DamagePerDollar       float64               `json:"DamagePerDollar"`   // Damage / TotalSpent
+	KillsPer1000          float64               `json:"KillsPer1000"`      // Kills per $1000 spent
+	AvgStartMoney         float64               `json:"AvgStartMoney"`     // Money at round start
+	AvgEquipmentValue     float64               `json:"AvgEquipmentValue"` // Equipment value at freezetime end
+	ValueLostToDeath      int                   `json:"ValueLostToDeath"`  // Equipment value carried at each death, summed
+	EntryKills            int                   `json:"EntryKills"`
+	EcoKills              int                   `json:"EcoKills"` // Kills on enemies on an eco or force buy
+	EntryDeaths           int                   `json:"EntryDeaths"`
+	OpeningImpact         float64               `json:"OpeningImpact"`    // Entry kills weighted by what the victim had bought
+	TimeToFirstKill       float64               `json:"TimeToFirstKill"`  // Avg seconds after freezetime to the player's first kill of a round
+	TimesEntryTraded      int                   `json:"TimesEntryTraded"` // Opening death that a teammate traded
+	OpeningWinRate        float64               `json:"OpeningWinRate"`   // % of opening duels won
+	OpeningWinRateT       float64               `json:"OpeningWinRateT"`
+	OpeningWinRateCT      float64               `json:"OpeningWinRateCT"`
+	FirstDeaths           int                   `json:"FirstDeaths"`    // First on own team to die in a round
+	TimesLastAlive        int                   `json:"TimesLastAlive"` // Last alive on own team (clutch entered)
+	Saves                 int                   `json:"Saves"`          // Survived a lost round with a real weapon
+	ClutchWins            int                   `json:"ClutchWins"`     // 1vX wins
+	ClutchLosses          map[int]int           `json:"ClutchLosses"`   // 1vX situations lost, keyed by X
+	OneVOneWins           int                   `json:"OneVOneWins"`    // Rounds won after it came down to 1v1 with this player
+	OneVOneLosses         int                   `json:"OneVOneLosses"`
+	LowHPKills            int                   `json:"LowHPKills"`     // Kills made on lowHPKill HP or less
+	KillsWhenAhead        int                   `json:"KillsWhenAhead"` // Own team had more players alive before the kill
+	KillsWhenBehind       int                   `json:"KillsWhenBehind"`
+	KillsWhenEven         int                   `json:"KillsWhenEven"`
+	AvgHPAtKill           float64               `json:"AvgHPAtKill"`
+	TradeKills            int                   `json:"TradeKills"`           // Kills on someone who just killed a teammate
+	KAST                  float64               `json:"KAST"`                 // % of rounds with a kill, assist, survival or trade
+	AvgTimeAlive          float64               `json:"AvgTimeAlive"`         // Seconds after freezetime until death, or to round end if survived
+	AggressionIndex       float64               `json:"AggressionIndex"`      // Match avg opening duel time / own, above 1 = takes first fights earlier
+	AvgDamageBeforeDeath  float64               `json:"AvgDamageBeforeDeath"` // Damage dealt in a round before dying in it
+	MultiKills            map[int]int           `json:"MultiKills"`           // 1k, 2k, 3k, 4k, 5k count
+	MultiKillRounds       []MultiKillRound      `json:"MultiKillRounds"`      // Which rounds the multi-kills happened in, see -min-multikill
+	Aces                  int                   `json:"Aces"`                 // 5k rounds
+	MultiTargetRounds     int                   `json:"MultiTargetRounds"`    // Rounds damaging 2+ different enemies
+	WeaponKills           map[string]int        `json:"WeaponKills"`          // Kills per weapon
+	WeaponStats           map[string]WeaponStat `json:"WeaponStats"`          // Per gun: kills, headshots, shots and accuracy
+	KillsByCategory       map[string]int        `json:"KillsByCategory"`      // rifle, pistol, sniper, smg, shotgun, heavy, grenade, knife, zeus
+	ZeusKills             int                   `json:"ZeusKills"`
+	CollateralKills       int                   `json:"CollateralKills"` // Shots that killed 2+ players
+	JumpKills             int                   `json:"JumpKills"`       // Killer was airborne
+	TeamKills             int                   `json:"TeamKills"`       // Not in Kills or any other kill stat
+	AvgKillDistance       float64               `json:"AvgKillDistance"` // Game units
+	MaxKillDistance       float64               `json:"MaxKillDistance"`
+	BombPlants            int                   `json:"BombPlants"`
+	BombDefuses           int                   `json:"BombDefuses"`
+	BombKills             int                   `json:"BombKills"` // Enemies killed by a bomb this player planted
+	BombPickups           int                   `json:"BombPickups"`
+	BombDrops             int                   `json:"BombDrops"`
+	PlantedRounds         []int                 `json:"PlantedRounds"`  // Rounds this player planted in
+	EnemiesSpotted        int                   `json:"EnemiesSpotted"` // Only with -spotted
+	Headshots             int                   `json:"Headshots"`      // Raw count
+
+	// Raw accumulators behind the derived fields, not part of the output
+	RoundsPlayed            int     `json:"-"`
+	KASTRounds              int     `json:"-"`
+	KillDistanceTotal       float64 `json:"-"`
+	KillDistanceCount       int     `json:"-"`
+	StartMoneyTotal         int     `json:"-"`
+	StartMoneyRounds        int     `json:"-"`
+	EquipValueTotal         int     `json:"-"`
+	EquipValueRounds        int     `json:"-"`
+	FirstKillTimeTotal      float64 `json:"-"`
+	FirstKillRounds         int     `json:"-"`
+	EntryKillsT             int     `json:"-"`
+	EntryKillsCT            int     `json:"-"`
+	EntryDeathsT            int     `json:"-"`
+	EntryDeathsCT           int     `json:"-"`
+	EnemyBlindTime          float64 `json:"-"`
+	HPAtKillTotal           int     `json:"-"`
+	HPAtKillCount           int     `json:"-"`
+	TimeAliveTotal          float64 `json:"-"`
+	DamageBeforeDeathTotal  int     `json:"-"`
+	DamageBeforeDeathRounds int     `json:"-"`
+	OpeningDuelTimeTotal    float64 `json:"-"`
+	OpeningDuelCount        int     `json:"-"`
+	MatchRounds             int     `json:"-"` // Rounds of the match(es) this row covers, ADR and Rating divide by it
+}
+
+// MultiKillRound records a single 2k+ round for a player
+type MultiKillRound struct {
+	Round int `json:"Round"`
+	Kills int `json:"Kills"`
+}
+
+// WeaponStat is one player's record with one gun
+type WeaponStat struct {
+	Kills      int     `json:"Kills"`
+	Headshots  int     `json:"Headshots"`
+	ShotsFired int     `json:"ShotsFired"`
+	ShotsHit   int     `json:"ShotsHit"` // Shots that hit an enemy, a shotgun blast counts once
+	Accuracy   float64 `json:"Accuracy"` // % of shots that hit
+	HSPercent  float64 `json:"HS%"`
+}
+
+// RoundEconomy holds each team's buy for a round, taken at freezetime end
+type RoundEconomy struct {
+	Round            int `json:"round"`
+	TEquipmentValue  int `json:"t_equipment_value"`
+	CTEquipmentValue int `json:"ct_equipment_value"`
+	TMoney           int `json:"t_money"`
+	CTMoney          int `json:"ct_money"`
+}
+
+// SchemaVersion identifies the output shape. Bump it whenever fields are
+// added, removed or renamed so consumers can branch on it.
+const SchemaVersion = 57
+
+// MatchResult holds the final output structure
+type MatchResult struct {
+	SchemaVersion         int               `json:"schema_version"`
+	File                  string            `json:"file,omitempty"` // Set by the caller, go_parser does in multi-file mode
+	ScoreStr              string            `json:"score_str"`
+	Stats                 []PlayerStats     `json:"stats"`
+	MapName               string            `json:"map_name"`
+	ScoreT                int               `json:"score_t"`
+	ScoreCT               int               `json:"score_ct"`
+	EconomyTimeline       []RoundEconomy    `json:"economy_timeline"`
+	AvgFirstContactTime   float64           `json:"avg_first_contact_time"`    // Avg seconds after freezetime to a round's first kill
+	AvgTSideFirstContact  float64           `json:"avg_t_side_first_contact"`  // Same, over rounds where T took the opening kill
+	AvgCTSideFirstContact float64           `json:"avg_ct_side_first_contact"` // Same, over rounds where CT took the opening kill
+	RoundTime             float64           `json:"round_time"`                // Seconds, from the game rules, 0 if unknown
+	FreezeTime            float64           `json:"freeze_time"`               // Seconds, mp_freezetime
+	BombTime              float64           `json:"bomb_time"`                 // Seconds, mp_c4timer
+	StatsFirstHalf        []PlayerStats     `json:"stats_first_half,omitempty"`
+	StatsSecondHalf       []PlayerStats     `json:"stats_second_half,omitempty"` // Includes overtime
+	Killfeed              []KillEvent       `json:"killfeed,omitempty"`
+	Rounds                []RoundSummary    `json:"rounds,omitempty"`             // Only with -rounds
+	LossBonus             []LossBonusTotals `json:"loss_bonus,omitempty"`         // T then CT, economy group
+	CommonNadeSpots       []NadeSpot        `json:"common_nade_spots,omitempty"`  // Only with -nade-spots
+	OpeningDuels          []OpeningDuel     `json:"opening_duels"`                // Who took each round's first kill from whom
+	Teams                 []TeamTotals      `json:"teams"`                        // T then CT, only the -players if given
+	BuyTypeWinRates       []BuyTypeWinRate  `json:"buy_type_win_rates,omitempty"` // Economy group
+	PlantRounds           PlantRounds       `json:"plant_rounds"`
+	PlayerCount           int               `json:"player_count"`        // Distinct humans who played on T or CT
+	Skipped               bool              `json:"skipped,omitempty"`   // Map not in -only-maps, nothing but map_name is filled in
+	DemoHash              string            `json:"demo_hash,omitempty"` // SHA-256 of the uncompressed demo, only with -webhook
+	Warnings              []string          `json:"warnings,omitempty"`  // Non-fatal parser problems
+	Error                 string            `json:"error,omitempty"`     // Set by the caller when Parse fails
+
+	teamsOnly bool // Options.TeamsOnly: encoded as a teamsOnlyResult
+}
+
+// teamsOnlyResult is the JSON form of a -teams-only MatchResult: the team
+// totals, score and map, and nothing per player or per round
+type teamsOnlyResult struct {
+	SchemaVersion int          `json:"schema_version"`
+	File          string       `json:"file,omitempty"`
+	ScoreStr      string       `json:"score_str"`
+	MapName       string       `json:"map_name"`
+	ScoreT        int          `json:"score_t"`
+	ScoreCT       int          `json:"score_ct"`
+	Winner        int          `json:"winner"` // Team number as in teams, 0 on a draw
+	Teams         []TeamTotals `json:"teams"`
+	DemoHash      string       `json:"demo_hash,omitempty"`
+	Warnings      []string     `json:"warnings,omitempty"`
+}
+
+// MarshalJSON encodes a -teams-only result in its trimmed shape
+func (r MatchResult) MarshalJSON() ([]byte, error) {
+	type plain MatchResult // Without this method
+	if !r.teamsOnly {
+		return json.Marshal(plain(r))
+	}
+	trimmed := teamsOnlyResult{
+		SchemaVersion: r.SchemaVersion,
+		File:          r.File,
+		ScoreStr:      r.ScoreStr,
+		MapName:       r.MapName,
+		ScoreT:        r.ScoreT,
+		ScoreCT:       r.ScoreCT,
+		Teams:         r.Teams,
+		DemoHash:      r.DemoHash,
+		Warnings:      r.Warnings,
+	}
+	for _, t := range r.Teams {
+		if t.Won {
+			trimmed.Winner = t.TeamNum
+		}
+	}
+	return json.Marshal(trimmed)
+}
+
+// KillEvent is one killfeed entry, only with -killfeed
+type KillEvent struct {
+	Round         int     `json:"round"`
+	Time          float64 `json:"time"`  // Seconds after freezetime
+	Clock         float64 `json:"clock"` // Seconds left on the round clock, or on the bomb once planted
+	Killer        uint64  `json:"killer,omitempty"`
+	Victim        uint64  `json:"victim,omitempty"`
+	Assister      uint64  `json:"assister,omitempty"`
+	Weapon        string  `json:"weapon"`
+	Headshot      bool    `json:"headshot"`
+	Wallbang      bool    `json:"wallbang"`
+	NoScope       bool    `json:"noscope"`
+	ThroughSmoke  bool    `json:"through_smoke"`
+	AttackerBlind bool    `json:"attacker_blind"`
+	AssistedFlash bool    `json:"assisted_flash"`
+}
+
+// RoundSummary describes one round, only with -rounds
+type RoundSummary struct {
+	Round        int             `json:"round"`
+	Phase        string          `json:"phase"`  // first_half, second_half, ot1_first, ot1_second, ot2_first...
+	Winner       int             `json:"winner"` // Team number, 2 = T, 3 = CT
+	Survivors    []RoundSurvivor `json:"survivors"`
+	RoundMVP     uint64          `json:"round_mvp,omitempty"` // SteamID with the most impact, see clutchImpact
+	TLossStreak  int             `json:"t_loss_streak"`       // Rounds lost in a row going into this one, only with the economy group
+	CTLossStreak int             `json:"ct_loss_streak"`      // 0 = won the last round or first round of a half
+	Bomb         *RoundBomb      `json:"bomb,omitempty"`      // Only for rounds with a plant
+}
+
+// RoundBomb is what happened to a planted bomb. Times are seconds after
+// freezetime, like the killfeed.
+type RoundBomb struct {
+	Site              string    `json:"site"`
+	PlantTime         float64   `json:"plant_time"`
+	Outcome           string    `json:"outcome"`       // defused, exploded, or empty if neither happened
+	DefuseStarts      []float64 `json:"defuse_starts"` // Every defuse attempt
+	DefuseTime        float64   `json:"defuse_time,omitempty"`
+	DefuseInterrupted bool      `json:"defuse_interrupted"` // An attempt was aborted before the end
+}
+
+// LossBonusTotals sums one side's loss bonus rounds over the match
+type LossBonusTotals struct {
+	Side              int `json:"side"`             // Team number, 2 = T, 3 = CT
+	BonusRounds       int `json:"bonus_rounds"`     // Played with some loss bonus
+	MaxBonusRounds    int `json:"max_bonus_rounds"` // Played with the highest loss bonus
+	LongestLossStreak int `json:"longest_loss_streak"`
+}
+
+// BuyTypeWinRate is how often one team won the rounds it played on a buy type
+type BuyTypeWinRate struct {
+	TeamNum int     `json:"team_num"` // The team as in teams: 2 = T, 3 = CT at the end of the match
+	BuyType string  `json:"buy_type"` // eco, force or full, by the team's average equipment value
+	Rounds  int     `json:"rounds"`
+	WinRate float64 `json:"win_rate"` // %
+}
+
+// PlantRounds is how the rounds with a planted bomb ended
+type PlantRounds struct {
+	Plants           int     `json:"plants"`
+	PostPlantWins    int     `json:"post_plant_wins"`     // T held the plant and won
+	PostPlantWinRate float64 `json:"post_plant_win_rate"` // %
+	Retakes          int     `json:"retakes"`             // CT won anyway
+	RetakeRate       float64 `json:"retake_rate"`         // %
+}
+
+// TeamTotals adds up one team's players, by the side they finished on
+type TeamTotals struct {
+	TeamNum       int     `json:"team_num"` // 2 = T, 3 = CT at the end of the match
+	Score         int     `json:"score"`
+	Won           bool    `json:"won"`
+	Players       int     `json:"players"`
+	Kills         int     `json:"kills"`
+	Deaths        int     `json:"deaths"`
+	Assists       int     `json:"assists"`
+	Damage        int     `json:"damage"`
+	UtilityDamage int     `json:"utility_damage"`
+	ADR           float64 `json:"adr"`
+}
+
+// OpeningDuel is the first kill of a round
+type OpeningDuel struct {
+	Round  int    `json:"round"`
+	Winner uint64 `json:"winner"` // SteamIDs
+	Loser  uint64 `json:"loser"`
+}
+
+// NadeSpot is a place grenades of one type keep landing, only with -nade-spots
+type NadeSpot struct {
+	Type  string  `json:"type"`
+	X     float64 `json:"x"` // Average detonation position of the cluster
+	Y     float64 `json:"y"`
+	Z     float64 `json:"z"`
+	Count int     `json:"count"`
+}
+
+// RoundSurvivor is a player still alive when the round ended
+type RoundSurvivor struct {
+	Player  string `json:"player"`
+	SteamID uint64 `json:"steam_id"`
+	TeamNum int    `json:"team_num"`
+	HP      int    `json:"hp"`
+}
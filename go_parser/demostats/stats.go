@@ -0,0 +1,358 @@
+package demostats
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/geo/r3"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// FinalizeStats computes the derived stats for every tracked player over
+// totalRounds, or the player's own MatchRounds when already set (-aggregate),
+// and returns the filtered, sorted scoreboard.
+// The order doesn't depend on map iteration, so parsing the same demo twice
+// gives identical output (encoding/json already sorts map keys).
+func FinalizeStats(stats map[uint64]*PlayerStats, totalRounds int, opts Options) []PlayerStats {
+	// Every opening duel counts for both players, so this is the match's
+	// average first contact time
+	var duelTime float64
+	var duels int
+	for _, s := range stats {
+		duelTime += s.OpeningDuelTimeTotal
+		duels += s.OpeningDuelCount
+	}
+
+	var statsList []PlayerStats
+	for _, s := range stats {
+		if len(opts.PlayerFilter) > 0 && !opts.PlayerFilter[s.SteamID] {
+			continue
+		}
+		if !opts.IncludeDisconnected && !s.Connected {
+			continue
+		}
+		// Skip coaches / spectators that never played a round
+		if s.RoundsPlayed == 0 && s.Kills == 0 && s.Deaths == 0 && s.Damage == 0 {
+			continue
+		}
+
+		if s.MatchRounds == 0 {
+			s.MatchRounds = totalRounds
+		}
+		rounds := s.MatchRounds
+
+		// Calculate derived stats
+		if s.Kills > 0 {
+			s.HSPercent = (float64(s.Headshots) / float64(s.Kills)) * 100
+		}
+		if s.Deaths == 0 {
+			s.KD = float64(s.Kills)
+		} else {
+			s.KD = float64(s.Kills) / float64(s.Deaths)
+		}
+		if rounds > 0 {
+			s.ADR = float64(s.Damage) / float64(rounds)
+			s.UtilityADR = float64(s.UtilityDamage) / float64(rounds)
+		}
+		if rounds > 0 {
+			s.Rating = hltvRating(s, rounds)
+		}
+		if s.RoundsPlayed > 0 {
+			s.KAST = float64(s.KASTRounds) / float64(s.RoundsPlayed) * 100
+		}
+		if s.RoundsPlayed > 0 {
+			thrown := 0
+			for _, n := range s.GrenadesThrown {
+				thrown += n
+			}
+			s.UtilityPerRound = float64(thrown) / float64(s.RoundsPlayed)
+		}
+		if s.TotalSpent > 0 {
+			s.DamagePerDollar = float64(s.Damage) / float64(s.TotalSpent)
+			s.KillsPer1000 = float64(s.Kills) / float64(s.TotalSpent) * 1000
+		}
+		if flashes := s.GrenadesThrown[common.EqFlash.String()]; flashes > 0 {
+			s.FlashEfficiency = float64(s.Flashed) / float64(flashes)
+			s.AvgEnemyBlindPerFlash = s.EnemyBlindTime / float64(flashes)
+		}
+		if s.StartMoneyRounds > 0 {
+			s.AvgStartMoney = float64(s.StartMoneyTotal) / float64(s.StartMoneyRounds)
+		}
+		if s.EquipValueRounds > 0 {
+			s.AvgEquipmentValue = float64(s.EquipValueTotal) / float64(s.EquipValueRounds)
+		}
+		if s.FirstKillRounds > 0 {
+			s.TimeToFirstKill = s.FirstKillTimeTotal / float64(s.FirstKillRounds)
+		}
+		s.OpeningWinRate = winRate(s.EntryKills, s.EntryDeaths)
+		s.OpeningWinRateT = winRate(s.EntryKillsT, s.EntryDeathsT)
+		s.OpeningWinRateCT = winRate(s.EntryKillsCT, s.EntryDeathsCT)
+		if s.KillDistanceCount > 0 {
+			s.AvgKillDistance = s.KillDistanceTotal / float64(s.KillDistanceCount)
+		}
+		s.Aces = s.MultiKills[5]
+		if s.DamageBeforeDeathRounds > 0 {
+			s.AvgDamageBeforeDeath = opts.round(float64(s.DamageBeforeDeathTotal)/float64(s.DamageBeforeDeathRounds), 1)
+		}
+		if s.RoundsPlayed > 0 {
+			s.AvgTimeAlive = opts.round(s.TimeAliveTotal/float64(s.RoundsPlayed), 1)
+		}
+		if s.OpeningDuelCount > 0 && s.OpeningDuelTimeTotal > 0 {
+			s.AggressionIndex = opts.round(duelTime/float64(duels)/(s.OpeningDuelTimeTotal/float64(s.OpeningDuelCount)), 2)
+		}
+		if s.HPAtKillCount > 0 {
+			s.AvgHPAtKill = opts.round(float64(s.HPAtKillTotal)/float64(s.HPAtKillCount), 1)
+		}
+		// Halves are merged by appending, drop the repeats
+		var names []string
+		for _, name := range s.AllNames {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+		s.AllNames = names
+		for w, ws := range s.WeaponStats {
+			if ws.ShotsFired > 0 {
+				ws.Accuracy = opts.round(float64(ws.ShotsHit)/float64(ws.ShotsFired)*100, 1)
+			}
+			if ws.Kills > 0 {
+				ws.HSPercent = opts.round(float64(ws.Headshots)/float64(ws.Kills)*100, 1)
+			}
+			s.WeaponStats[w] = ws
+		}
+		// Rounding
+		s.KD = opts.round(s.KD, 2)
+		s.HSPercent = opts.round(s.HSPercent, 1)
+		s.ADR = opts.round(s.ADR, 1)
+		s.UtilityADR = opts.round(s.UtilityADR, 1)
+		s.Rating = opts.round(s.Rating, 2)
+		s.KAST = opts.round(s.KAST, 1)
+		s.UtilityPerRound = opts.round(s.UtilityPerRound, 2)
+		s.FlashEfficiency = opts.round(s.FlashEfficiency, 2)
+		s.DamagePerDollar = opts.round(s.DamagePerDollar, 3)
+		s.KillsPer1000 = opts.round(s.KillsPer1000, 2)
+		s.AvgEnemyBlindPerFlash = opts.round(s.AvgEnemyBlindPerFlash, 2)
+		s.FireAreaDenialTime = opts.round(s.FireAreaDenialTime, 1)
+		s.AvgKillDistance = opts.round(s.AvgKillDistance, 1)
+		s.AvgStartMoney = opts.round(s.AvgStartMoney, 1)
+		s.AvgEquipmentValue = opts.round(s.AvgEquipmentValue, 1)
+		s.MaxKillDistance = opts.round(s.MaxKillDistance, 1)
+		s.TimeToFirstKill = opts.round(s.TimeToFirstKill, 1)
+		s.OpeningWinRate = opts.round(s.OpeningWinRate, 1)
+		s.OpeningImpact = opts.round(s.OpeningImpact, 2)
+		s.OpeningWinRateT = opts.round(s.OpeningWinRateT, 1)
+		s.OpeningWinRateCT = opts.round(s.OpeningWinRateCT, 1)
+
+		statsList = append(statsList, *s)
+	}
+
+	// Sort descending by the chosen field, SteamID breaks ties so the
+	// order is the same on every run
+	key := func(s PlayerStats) float64 {
+		switch opts.SortBy {
+		case "kills":
+			return float64(s.Kills)
+		case "adr":
+			return s.ADR
+		case "rating":
+			return s.Rating
+		case "kd":
+			return s.KD
+		}
+		return float64(s.Score)
+	}
+	sort.Slice(statsList, func(i, j int) bool {
+		ki, kj := key(statsList[i]), key(statsList[j])
+		if ki != kj {
+			return ki > kj
+		}
+		return statsList[i].SteamID < statsList[j].SteamID
+	})
+
+	// Only the listing is cut, match-level numbers use every player
+	if opts.Top > 0 && len(statsList) > opts.Top {
+		statsList = statsList[:opts.Top]
+	}
+
+	return statsList
+}
+
+// hltvRating computes the HLTV 1.0 rating: kills, survival and multi-kill
+// rounds per round, each normalised against the average player.
+func hltvRating(s *PlayerStats, rounds int) float64 {
+	r := float64(rounds)
+	killRating := float64(s.Kills) / r / 0.679
+	survivalRating := float64(rounds-s.Deaths) / r / 0.317
+	multiKills := 0
+	for kills, count := range s.MultiKills {
+		multiKills += kills * kills * count
+	}
+	multiKillRating := float64(multiKills) / r / 1.277
+	return (killRating + 0.7*survivalRating + multiKillRating) / 2.7
+}
+
+// Buy types by equipment value at freezetime end
+const (
+	ecoEquipmentValue     = 1500 // Below this: pistol and armor at most
+	fullBuyEquipmentValue = 4000 // From here on: rifle/AWP with utility
+)
+
+// buyType classifies an equipment value as "eco", "force" or "full"
+func buyType(value int) string {
+	switch {
+	case value < ecoEquipmentValue:
+		return "eco"
+	case value < fullBuyEquipmentValue:
+		return "force"
+	}
+	return "full"
+}
+
+// Weights for OpeningImpact: killing a full-buy rifler first swings a round
+// far more than picking off someone on an eco.
+var openingWeights = map[string]float64{
+	"eco":   0.5,
+	"force": 1.0,
+	"full":  1.5,
+}
+
+// openingWeight is what an opening kill on victim is worth for OpeningImpact
+func openingWeight(victim *common.Player) float64 {
+	if victim == nil {
+		return openingWeights["force"]
+	}
+	return openingWeights[buyType(victim.EquipmentValueFreezeTimeEnd())]
+}
+
+// roundPhase names the half or overtime half round (1-based) belongs to,
+// using mp_maxrounds and mp_overtime_maxrounds or the MR12 defaults.
+func roundPhase(round int, conVars map[string]string) string {
+	maxRounds, err := strconv.Atoi(conVars["mp_maxrounds"])
+	if err != nil || maxRounds <= 0 {
+		maxRounds = 24
+	}
+	otMaxRounds, err := strconv.Atoi(conVars["mp_overtime_maxrounds"])
+	if err != nil || otMaxRounds <= 0 {
+		otMaxRounds = 6
+	}
+	switch {
+	case round <= maxRounds/2:
+		return "first_half"
+	case round <= maxRounds:
+		return "second_half"
+	}
+	otRound := round - maxRounds - 1
+	ot := otRound/otMaxRounds + 1
+	if otRound%otMaxRounds < otMaxRounds/2 {
+		return fmt.Sprintf("ot%d_first", ot)
+	}
+	return fmt.Sprintf("ot%d_second", ot)
+}
+
+// segmentNear reports whether the segment from a to b passes within r of c
+func segmentNear(a, b, c r3.Vector, r float64) bool {
+	ab := b.Sub(a)
+	t := 0.0
+	if l := ab.Norm2(); l > 0 {
+		t = math.Max(0, math.Min(1, c.Sub(a).Dot(ab)/l))
+	}
+	return a.Add(ab.Mul(t)).Sub(c).Norm() <= r
+}
+
+// teamTotals sums the T and CT players in stats that pass -players. Unlike
+// FinalizeStats it ignores -top, which only shortens the listing.
+func teamTotals(stats map[uint64]*PlayerStats, totalRounds int, opts Options) []TeamTotals {
+	teams := []TeamTotals{{TeamNum: int(common.TeamTerrorists)}, {TeamNum: int(common.TeamCounterTerrorists)}}
+	for _, s := range stats {
+		for i := range teams {
+			t := &teams[i]
+			if s.TeamNum != t.TeamNum || s.RoundsPlayed == 0 {
+				continue
+			}
+			if len(opts.PlayerFilter) > 0 && !opts.PlayerFilter[s.SteamID] {
+				continue
+			}
+			t.Players++
+			t.Kills += s.Kills
+			t.Deaths += s.Deaths
+			t.Assists += s.Assists
+			t.Damage += s.Damage
+			t.UtilityDamage += s.UtilityDamage
+		}
+	}
+	if totalRounds > 0 {
+		for i := range teams {
+			teams[i].ADR = opts.round(float64(teams[i].Damage)/float64(totalRounds), 1)
+		}
+	}
+	return teams
+}
+
+// winRate returns wins as a percentage of wins+losses, 0 if there were none
+func winRate(wins, losses int) float64 {
+	if wins+losses == 0 {
+		return 0
+	}
+	return float64(wins) / float64(wins+losses) * 100
+}
+
+// AddStats adds src's raw counters into dst so two accumulations (e.g. the
+// halves of a match) can be combined. Numbers are summed, maps merged and
+// slices appended, Max* fields keep the larger value. Identity fields (and
+// Rank and Connected, which describe the latest demo) take src's value when
+// set. Derived fields are meaningless until FinalizeStats.
+func AddStats(dst, src *PlayerStats) {
+	dv := reflect.ValueOf(dst).Elem()
+	sv := reflect.ValueOf(src).Elem()
+	for i := 0; i < dv.NumField(); i++ {
+		name := dv.Type().Field(i).Name
+		d, v := dv.Field(i), sv.Field(i)
+		switch {
+		case name == "Player" || name == "SteamID" || name == "TeamNum" || name == "Rank" || name == "Connected":
+			if !v.IsZero() {
+				d.Set(v)
+			}
+		case d.Kind() == reflect.Int:
+			if strings.HasPrefix(name, "Max") {
+				d.SetInt(max(d.Int(), v.Int()))
+			} else {
+				d.SetInt(d.Int() + v.Int())
+			}
+		case d.Kind() == reflect.Float64:
+			if strings.HasPrefix(name, "Max") {
+				d.SetFloat(math.Max(d.Float(), v.Float()))
+			} else {
+				d.SetFloat(d.Float() + v.Float())
+			}
+		case d.Kind() == reflect.Map:
+			if d.IsNil() {
+				d.Set(reflect.MakeMap(d.Type()))
+			}
+			iter := v.MapRange()
+			for iter.Next() {
+				sum := iter.Value()
+				if cur := d.MapIndex(iter.Key()); cur.IsValid() && sum.Kind() == reflect.Int {
+					sum = reflect.ValueOf(cur.Int() + sum.Int()).Convert(sum.Type())
+				} else if cur.IsValid() && sum.Kind() == reflect.Struct {
+					// e.g. WeaponStat: sum the counters, the rest is derived later
+					merged := reflect.New(sum.Type()).Elem()
+					merged.Set(cur)
+					for j := 0; j < merged.NumField(); j++ {
+						if f := merged.Field(j); f.Kind() == reflect.Int {
+							f.SetInt(f.Int() + sum.Field(j).Int())
+						}
+					}
+					sum = merged
+				}
+				d.SetMapIndex(iter.Key(), sum)
+			}
+		case d.Kind() == reflect.Slice:
+			d.Set(reflect.AppendSlice(d, v))
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package demostats
+
+import (
+	"strings"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// worldWeapon is the WeaponKills and killfeed name for kills without a
+// weapon, e.g. the bomb or fall damage credited to a player
+const worldWeapon = "world"
+
+// CanonicalWeapons are the only weapon names used as keys in the output
+// (WeaponKills, WeaponStats, the killfeed), whatever the demo calls them
+var CanonicalWeapons = []string{
+	"AK-47", "AUG", "AWP", "C4", "CZ75 Auto", "Decoy Grenade", "Desert Eagle",
+	"Dual Berettas", "FAMAS", "Five-SeveN", "Flashbang", "G3SG1", "Galil AR",
+	"Glock-18", "HE Grenade", "Incendiary Grenade", "Knife", "M249", "M4A1-S",
+	"M4A4", "MAC-10", "MAG-7", "Molotov", "MP5-SD", "MP7", "MP9", "Negev",
+	"Nova", "P2000", "P250", "P90", "PP-Bizon", "R8 Revolver", "Sawed-Off",
+	"SCAR-20", "SG 553", "Smoke Grenade", "SSG 08", "Tec-9", "UMP-45",
+	"USP-S", "XM1014", "Zeus x27", worldWeapon,
+}
+
+// weaponAliases maps weaponKey of every known spelling to its canonical
+// name. Each canonical name is an alias of itself; the rest are entity and
+// older display names. "m4a1" is the M4A4, as in the weapon_m4a1 entity.
+var weaponAliases = func() map[string]string {
+	aliases := map[string]string{
+		"bizon": "PP-Bizon", "c4": "C4", "plantedc4": "C4", "cz75": "CZ75 Auto",
+		"cz75a": "CZ75 Auto", "deagle": "Desert Eagle", "decoy": "Decoy Grenade",
+		"elite": "Dual Berettas", "galil": "Galil AR", "glock": "Glock-18",
+		"hegrenade": "HE Grenade", "hkp2000": "P2000", "incgrenade": "Incendiary Grenade",
+		"incendiary": "Incendiary Grenade", "inferno": "Incendiary Grenade",
+		"m4a1": "M4A4", "m4a1s": "M4A1-S", "m4a1silencer": "M4A1-S", "m4a1silenceroff": "M4A1-S",
+		"mp5": "MP5-SD", "revolver": "R8 Revolver", "r8": "R8 Revolver",
+		"scout": "SSG 08", "sg556": "SG 553", "taser": "Zeus x27", "zeus": "Zeus x27",
+		"ump": "UMP-45", "usp": "USP-S", "uspsilencer": "USP-S", "uspsilenceroff": "USP-S",
+		"molotovgrenade": "Molotov", "smoke": "Smoke Grenade", "flash": "Flashbang",
+	}
+	for _, name := range CanonicalWeapons {
+		aliases[weaponKey(name)] = name
+	}
+	return aliases
+}()
+
+// weaponKey reduces a weapon name to lowercase letters and digits without
+// the weapon_ prefix, so "weapon_ak47", "AK-47" and "ak47" all match.
+func weaponKey(name string) string {
+	name = strings.TrimPrefix(strings.ToLower(name), "weapon_")
+	var sb strings.Builder
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// normalizeWeapon returns name's canonical spelling. Knife skins are all
+// "Knife"; names nobody knows are kept as they are.
+func normalizeWeapon(name string) string {
+	key := weaponKey(name)
+	if canonical, ok := weaponAliases[key]; ok {
+		return canonical
+	}
+	if strings.HasPrefix(key, "knife") || key == "bayonet" {
+		return "Knife"
+	}
+	return name
+}
+
+// weaponTypeNames are canonical names for types whose display name would
+// normalize to the wrong weapon: demoinfocs shows the M4A1-S as "M4A1"
+var weaponTypeNames = map[common.EquipmentType]string{
+	common.EqM4A1: "M4A1-S",
+}
+
+// weaponName is eq's canonical name, see CanonicalWeapons
+func weaponName(eq *common.Equipment) string {
+	if name, ok := weaponTypeNames[eq.Type]; ok {
+		return name
+	}
+	name := eq.String()
+	if eq.Type == common.EqUnknown && eq.OriginalString != "" {
+		name = eq.OriginalString
+	}
+	return normalizeWeapon(name)
+}
+
+// weaponCategory groups a weapon into the KillsByCategory buckets.
+// Returns "" for anything that isn't a weapon (bomb, world, ...).
+func weaponCategory(eq *common.Equipment) string {
+	switch eq.Type {
+	case common.EqAWP, common.EqSSG08, common.EqScar20, common.EqG3SG1:
+		return "sniper"
+	case common.EqSawedOff, common.EqNova, common.EqMag7, common.EqXM1014:
+		return "shotgun"
+	case common.EqZeus:
+		return "zeus"
+	case common.EqKnife:
+		return "knife"
+	}
+	switch eq.Class() {
+	case common.EqClassPistols:
+		return "pistol"
+	case common.EqClassSMG:
+		return "smg"
+	case common.EqClassHeavy:
+		return "heavy"
+	case common.EqClassRifle:
+		return "rifle"
+	case common.EqClassGrenade:
+		return "grenade"
+	}
+	return ""
+}
+
+// isGun reports whether eq fires bullets, i.e. has an accuracy
+func isGun(eq *common.Equipment) bool {
+	switch weaponCategory(eq) {
+	case "", "knife", "grenade":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package demostats
+
+import (
+	"testing"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+func TestNormalizeWeapon(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"ak47", "AK-47"},
+		{"weapon_ak47", "AK-47"},
+		{"AK-47", "AK-47"},
+		{"weapon_usp_silencer", "USP-S"},
+		{"cz75a", "CZ75 Auto"},
+		{"weapon_m4a1", "M4A4"},
+		{"M4A4", "M4A4"},
+		{"weapon_m4a1_silencer", "M4A1-S"},
+		{"weapon_m4a1_silencer_off", "M4A1-S"},
+		{"M4A1-S", "M4A1-S"},
+		{"inferno", "Incendiary Grenade"},
+		{"knife_karambit", "Knife"},
+		{"weapon_bayonet", "Knife"},
+		{"World", worldWeapon},
+		{"Mystery Gun", "Mystery Gun"},
+	}
+	for _, tt := range tests {
+		if got := normalizeWeapon(tt.name); got != tt.want {
+			t.Errorf("normalizeWeapon(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalWeaponsAreStable(t *testing.T) {
+	for _, name := range CanonicalWeapons {
+		if got := normalizeWeapon(name); got != name {
+			t.Errorf("normalizeWeapon(%q) = %q, want it unchanged", name, got)
+		}
+	}
+}
+
+func TestWeaponName(t *testing.T) {
+	tests := []struct {
+		typ  common.EquipmentType
+		want string
+	}{
+		{common.EqM4A1, "M4A1-S"},
+		{common.EqM4A4, "M4A4"},
+		{common.EqAK47, "AK-47"},
+		{common.EqUSP, "USP-S"},
+		{common.EqIncendiary, "Incendiary Grenade"},
+		{common.EqWorld, worldWeapon},
+	}
+	for _, tt := range tests {
+		if got := weaponName(common.NewEquipment(tt.typ)); got != tt.want {
+			t.Errorf("weaponName(%v) = %q, want %q", tt.typ, got, tt.want)
+		}
+	}
+}
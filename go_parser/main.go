@@ -1,390 +1,928 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io/fs"
 	"os"
-	"sort"
+	"path/filepath"
+	"reflect"
+	runtimedebug "runtime/debug"
+	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"io"
 	"log"
+	"net/http"
 
-	demoinfocs "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
 	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
-	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go_parser/demostats"
+	"go_parser/matchpb"
 )
 
-// PlayerStats holds the aggregated stats for a player
-type PlayerStats struct {
-	Player        string         `json:"Player"`
-	SteamID       uint64         `json:"SteamID"`
-	TeamNum       int            `json:"TeamNum"`
-	Kills         int            `json:"Kills"`
-	Deaths        int            `json:"Deaths"`
-	Assists       int            `json:"Assists"`
-	KD            float64        `json:"K/D"`
-	ADR           float64        `json:"ADR"`
-	HSPercent     float64        `json:"HS%"`
-	Score         int            `json:"Score"`
-	Damage        int            `json:"Damage"`
-	UtilityDamage int            `json:"UtilityDamage"`
-	Flashed       int            `json:"Flashed"`     // Number of enemies flashed
-	TeamFlashed   int            `json:"TeamFlashed"` // Number of teammates flashed
-	FlashAssists  int            `json:"FlashAssists"`
-	TotalSpent    int            `json:"TotalSpent"`
-	EntryKills    int            `json:"EntryKills"`
-	EntryDeaths   int            `json:"EntryDeaths"`
-	ClutchWins    int            `json:"ClutchWins"`  // 1vX wins
-	MultiKills    map[int]int    `json:"MultiKills"`  // 1k, 2k, 3k, 4k, 5k count
-	WeaponKills   map[string]int `json:"WeaponKills"` // Kills per weapon
-	BombPlants    int            `json:"BombPlants"`
-	BombDefuses   int            `json:"BombDefuses"`
-	Headshots     int            `json:"Headshots"` // Raw count
-}
+// Exit codes, so scripts can detect failures without parsing stdout.
+// In multi-file mode the first failing demo's code is used.
+const (
+	exitUsage         = 1 // Bad arguments or flags
+	exitOpenFailure   = 2 // Demo couldn't be opened or downloaded
+	exitParseFailure  = 3 // Demo couldn't be parsed
+	exitOutputFailure = 4 // Result couldn't be encoded or written
+)
+
+// quiet sends errors to stderr only, without the error JSON on stdout
+var quiet bool
 
-// MatchResult holds the final output structure
-type MatchResult struct {
-	ScoreStr string        `json:"score_str"`
-	Stats    []PlayerStats `json:"stats"`
-	MapName  string        `json:"map_name"`
-	ScoreT   int           `json:"score_t"`
-	ScoreCT  int           `json:"score_ct"`
-	Error    string        `json:"error,omitempty"`
+// AggregateResult is the -aggregate output: one row per player over every
+// demo in the batch
+type AggregateResult struct {
+	SchemaVersion int                     `json:"schema_version"`
+	Demos         int                     `json:"demos"` // Parsed successfully and merged
+	Stats         []demostats.PlayerStats `json:"stats"`
+	Failed        []string                `json:"failed,omitempty"` // "file: error" for demos that couldn't be parsed
 }
 
 func main() {
 	// Silence default logger
 	log.SetOutput(io.Discard)
 
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: go_parser <demo_file>")
-		os.Exit(1)
+	defaults := demostats.DefaultOptions()
+	tradeWindow := flag.Duration("trade-window", defaults.TradeWindow, "how long after a teammate's death a kill still counts as a trade")
+	playersFlag := flag.String("players", "", "comma-separated SteamID64s to restrict the output to")
+	spotted := flag.Bool("spotted", false, "track how many enemies each player spotted (slower)")
+	statsFlag := flag.String("stats", "all", "comma-separated stat groups to compute: "+strings.Join(demostats.StatGroups, ",")+" or all")
+	precision := flag.Int("precision", defaults.Precision, "decimal places for all derived stats (default 2 for K/D and per-round rates, 1 otherwise)")
+	includeDisconnected := flag.Bool("include-disconnected", defaults.IncludeDisconnected, "include players who left before the end of the demo")
+	weaponByDamage := flag.Bool("weapon-by-damage", false, "credit WeaponKills to the weapon that did the most damage to the victim, not the finishing one")
+	sortBy := flag.String("sort", defaults.SortBy, "scoreboard order: score, kills, adr, rating or kd")
+	top := flag.Int("top", 0, "only list the first N players after sorting, 0 = all")
+	minMultiKill := flag.Int("min-multikill", defaults.MinMultiKill, "smallest number of kills in a round listed in MultiKillRounds (1-5)")
+	maxRound := flag.Int("max-round", 0, "stop parsing once this round has ended, 0 = whole demo")
+	countBombKills := flag.Bool("count-bomb-kills", defaults.CountBombKills, "count bomb explosion kills in Kills (they're always in BombKills)")
+	dir := flag.String("dir", "", "also parse every .dem, .dem.gz and .dem.bz2 in this directory (multi-file mode)")
+	recursive := flag.Bool("recursive", false, "with -dir, also search subdirectories")
+	onlyMapsFlag := flag.String("only-maps", "", "comma-separated maps (e.g. de_dust2,de_mirage) to parse, others are skipped after reading the header")
+	teamsOnly := flag.Bool("teams-only", false, "leave out the per-player stats, only output team totals, score and map")
+	killfeed := flag.Bool("killfeed", false, "include the full killfeed in the output")
+	rounds := flag.Bool("rounds", false, "include a per-round summary in the output")
+	nadeSpots := flag.Bool("nade-spots", false, "include the most common grenade detonation spots in the output")
+	scoreboard := flag.Bool("scoreboard", false, "print an aligned text scoreboard per team instead of JSON")
+	format := flag.String("format", "json", "output format: json, or protobuf (see -proto)")
+	outPath := flag.String("o", "", "write the output, error results included, to this file instead of stdout")
+	webhook := flag.String("webhook", "", "POST each result as JSON to this URL once its demo is parsed, retrying on failure")
+	anonymize := flag.Bool("anonymize", false, "replace player names and SteamIDs with stable pseudonyms (Player1 / 1, ...)")
+	printVersion := flag.Bool("version", false, "print the parser and demoinfocs versions and exit")
+	printWeapons := flag.Bool("weapons", false, "print the canonical weapon names used as output keys and exit")
+	printProto := flag.Bool("proto", false, "print the .proto definition of the protobuf output and exit")
+	printSchema := flag.Bool("schema", false, "print a JSON Schema of the output and exit")
+	header := flag.Bool("header", false, "only read each demo's header and print it as JSON, one line per demo")
+	validate := flag.Bool("validate", false, "only check that each demo parses, printing one line per demo")
+	eventLog := flag.Bool("events", false, "instead of stats, stream a chronological JSON log of key events")
+	aggregate := flag.Bool("aggregate", false, "merge every demo into one career row per player instead of per-demo results (JSON only)")
+	ndjson := flag.Bool("ndjson", false, "in multi-file mode, write one result per line as each demo finishes")
+	verbose := flag.Bool("verbose", false, "log parsing diagnostics (event counts, match start, halftime, rounds) to stderr")
+	flag.BoolVar(&quiet, "quiet", false, "on failure, write the error to stderr instead of emitting error JSON")
+	flag.CommandLine.Init(os.Args[0], flag.ContinueOnError)
+	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(exitUsage)
 	}
 
-	demoPath := os.Args[1]
-	f, err := os.Open(demoPath)
-	if err != nil {
-		outputError(fmt.Sprintf("Error opening file: %v", err))
+	if *printSchema {
+		schema := jsonSchema(reflect.TypeOf(demostats.MatchResult{}))
+		schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+		schema["title"] = "MatchResult"
+		json.NewEncoder(os.Stdout).Encode(schema)
 		return
 	}
-	defer f.Close()
 
-	p := demoinfocs.NewParser(f)
-	defer p.Close()
-
-	// Stats accumulation
-	stats := make(map[uint64]*PlayerStats) // Keyed by SteamID64
+	if *printVersion {
+		fmt.Println(versionString())
+		return
+	}
 
-	// Helper to get or create stats
-	getStats := func(p *common.Player) *PlayerStats {
-		if p == nil {
-			return nil
-		}
-		if _, ok := stats[p.SteamID64]; !ok {
-			stats[p.SteamID64] = &PlayerStats{
-				Player:      p.Name,
-				SteamID:     p.SteamID64,
-				TeamNum:     int(p.Team),
-				MultiKills:  make(map[int]int),
-				WeaponKills: make(map[string]int),
-			}
+	if *printWeapons {
+		for _, name := range demostats.CanonicalWeapons {
+			fmt.Println(name)
 		}
-		// Update name/team just in case
-		s := stats[p.SteamID64]
-		if p.Name != "" {
-			s.Player = p.Name
-		}
-		if p.Team > 0 {
-			s.TeamNum = int(p.Team)
-		}
-		return s
+		return
 	}
 
-	// Variables for round tracking
-	// var currentRoundDamage map[uint64]int // Unused
-	var totalRounds int
-	var scoreT, scoreCT int
-
-	// Round-specific temp data
-	var roundKills map[uint64]int
-	var firstKillOccurred bool
+	if *printProto {
+		fmt.Print(matchProto)
+		return
+	}
 
-	// Clutch Tracking State
-	var potentialClutcher *common.Player
-	var clutchOpponents int // opponent count when situation started
+	demoPaths := flag.Args()
+	if *dir != "" {
+		found, err := findDemos(*dir, *recursive)
+		if err != nil {
+			outputError(fmt.Sprintf("Error reading -dir: %v", err), exitOpenFailure)
+		}
+		demoPaths = append(demoPaths, found...)
+	}
+	// -dir always produces multi-file output, even if it only finds one demo
+	multiFile := len(demoPaths) > 1 || *dir != ""
 
-	// Init round data
-	p.RegisterEventHandler(func(e events.RoundStart) {
-		roundKills = make(map[uint64]int)
-		firstKillOccurred = false
-		potentialClutcher = nil
-		clutchOpponents = 0
-	})
+	if len(demoPaths) < 1 && *dir == "" {
+		fmt.Println("Usage: go_parser [flags] <demo_file> [demo_file...] or go_parser [flags] -dir <path>")
+		os.Exit(exitUsage)
+	}
 
-	// Track Deaths for Clutch Logic
-	p.RegisterEventHandler(func(e events.Kill) {
-		if !p.GameState().IsMatchStarted() {
-			return
+	// Optional output filter, the whole demo is still parsed
+	playerFilter := make(map[uint64]bool)
+	for _, id := range strings.Split(*playersFlag, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		steamID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			outputError(fmt.Sprintf("Invalid SteamID in -players: %q", id), exitUsage)
 		}
+		playerFilter[steamID] = true
+	}
 
-		// Existing Kill Logic
-		kStats := getStats(e.Killer)
-		vStats := getStats(e.Victim)
-		aStats := getStats(e.Assister)
+	groups := map[string]bool{"basic": true}
+	for _, g := range strings.Split(*statsFlag, ",") {
+		g = strings.TrimSpace(g)
+		if g == "" {
+			continue
+		}
+		if g == "all" {
+			for _, name := range demostats.StatGroups {
+				groups[name] = true
+			}
+			continue
+		}
+		known := false
+		for _, name := range demostats.StatGroups {
+			known = known || name == g
+		}
+		if !known {
+			outputError(fmt.Sprintf("Unknown stat group in -stats: %q", g), exitUsage)
+		}
+		groups[g] = true
+	}
 
-		if kStats != nil {
-			kStats.Kills++
-			roundKills[e.Killer.SteamID64]++
+	if *verbose {
+		demostats.Debug.SetOutput(os.Stderr)
+	}
 
-			if e.IsHeadshot {
-				kStats.Headshots++
-			}
+	switch *sortBy {
+	case "score", "kills", "adr", "rating", "kd":
+	default:
+		outputError(fmt.Sprintf("Unknown -sort field: %q", *sortBy), exitUsage)
+	}
+	if *format != "json" && *format != "protobuf" {
+		outputError(fmt.Sprintf("Unknown -format: %q", *format), exitUsage)
+	}
+	if *minMultiKill < 1 || *minMultiKill > 5 {
+		outputError(fmt.Sprintf("-min-multikill must be between 1 and 5, got %d", *minMultiKill), exitUsage)
+	}
+	if *maxRound < 0 {
+		outputError(fmt.Sprintf("-max-round must not be negative, got %d", *maxRound), exitUsage)
+	}
+	// More places than a float64 holds overflows the rounding to Inf/NaN,
+	// which JSON can't encode. -1 is the unset default.
+	if *precision < -1 || *precision > 15 {
+		outputError(fmt.Sprintf("-precision must be between 0 and 15, got %d", *precision), exitUsage)
+	}
+	onlyMaps := make(map[string]bool)
+	for _, m := range strings.Split(*onlyMapsFlag, ",") {
+		if m = strings.ToLower(strings.TrimSpace(m)); m != "" {
+			onlyMaps[m] = true
+		}
+	}
+	if *aggregate && *format != "json" {
+		outputError("-aggregate only supports -format json", exitUsage)
+	}
+	if *aggregate && *teamsOnly {
+		outputError("-aggregate sums player stats, it can't be combined with -teams-only", exitUsage)
+	}
+	if *top < 0 {
+		outputError(fmt.Sprintf("-top must not be negative, got %d", *top), exitUsage)
+	}
 
-			// Weapon Stats
-			if e.Weapon != nil {
-				wName := e.Weapon.String()
-				kStats.WeaponKills[wName]++
-			}
+	opts := demostats.Options{
+		TradeWindow:         *tradeWindow,
+		PlayerFilter:        playerFilter,
+		TrackSpotting:       *spotted,
+		Groups:              groups,
+		Precision:           *precision,
+		IncludeDisconnected: *includeDisconnected,
+		WeaponByDamage:      *weaponByDamage,
+		Killfeed:            *killfeed,
+		Rounds:              *rounds,
+		NadeSpots:           *nadeSpots,
+		SortBy:              *sortBy,
+		Top:                 *top,
+		MinMultiKill:        *minMultiKill,
+		MaxRound:            *maxRound,
+		CountBombKills:      *countBombKills,
+		OnlyMaps:            onlyMaps,
+		TeamsOnly:           *teamsOnly,
+	}
 
-			// Entry Kill Logic
-			if !firstKillOccurred {
-				kStats.EntryKills++
-				if vStats != nil {
-					vStats.EntryDeaths++
-				}
-				firstKillOccurred = true
+	// One mapping for the whole run, so a player keeps the same pseudonym
+	// in every demo
+	var anon *anonymizer
+	if *anonymize {
+		anon = &anonymizer{ids: make(map[uint64]uint64)}
+	}
+	parse := func(demoPath string, opts demostats.Options) (demostats.MatchResult, int) {
+		result, code := parseDemo(demoPath, opts, *webhook != "")
+		anon.apply(&result)
+		if *webhook != "" && result.Error == "" && !result.Skipped {
+			if err := postWebhook(*webhook, demoPath, result); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("webhook failed: %v", err))
 			}
 		}
-		if vStats != nil {
-			vStats.Deaths++
+		return result, code
+	}
+
+	var out io.Writer = os.Stdout
+	if *outPath != "" {
+		f, err := os.Create(*outPath)
+		if err != nil {
+			outputError(fmt.Sprintf("Error creating output file: %v", err), exitUsage)
 		}
-		if aStats != nil {
-			aStats.Assists++
-			if e.AssistedFlash {
-				aStats.FlashAssists++
+		defer f.Close()
+		out = f
+	}
+	encoder := json.NewEncoder(out)
+
+	// Header mode: metadata only, nothing past the header is read
+	if *header {
+		exitCode := 0
+		for _, demoPath := range demoPaths {
+			result, code := readHeader(demoPath)
+			if exitCode == 0 {
+				exitCode = code
 			}
+			if quietFailure(demoPath, result.Error) {
+				continue
+			}
+			mustEncode(encoder, result)
 		}
+		os.Exit(exitCode)
+	}
 
-		// --- CLUTCH LOGIC ---
-		// Check the victim's team. If they dropped to 1 alive, that last guy is now clutching.
-		// Important: This logic triggers only on the timestamp the death happened.
-		// It doesn't handle if multiple people disconnect, but for demos it's fine.
-
-		victimTeam := e.Victim.Team
-		// Get alive members of that team AFTER this death
-		// The event happens before the state update fully propagates in some parsers,
-		// but usually IsAlive() on the victim is still true?
-		// Actually best to count manually or iterate participants
-
-		teamMembers := p.GameState().Team(victimTeam).Members()
-		aliveCount := 0
-		var lastSurvivor *common.Player
-
-		for _, m := range teamMembers {
-			// e.Victim is the one dying, so ignore him even if currently marked alive
-			if m.IsAlive() && m.SteamID64 != e.Victim.SteamID64 {
-				aliveCount++
-				lastSurvivor = m
+	// Validate mode: triage a batch without computing stats
+	if *validate {
+		exitCode := 0
+		for _, demoPath := range demoPaths {
+			result, code := validateDemo(demoPath)
+			if exitCode == 0 {
+				exitCode = code
+			}
+			if quietFailure(demoPath, result.Error) {
+				continue
 			}
+			mustEncode(encoder, result)
 		}
+		os.Exit(exitCode)
+	}
 
-		if aliveCount == 1 && lastSurvivor != nil {
-			// A clutch situation has begun for lastSurvivor
-			potentialClutcher = lastSurvivor
-
-			// Count enemies
-			enemyTeam := common.TeamCounterTerrorists
-			if victimTeam == common.TeamCounterTerrorists {
-				enemyTeam = common.TeamTerrorists
+	// Event log mode: no stats, one event per line. A failing demo is
+	// reported on stderr so the stream stays events only, and the rest of
+	// the batch still runs.
+	if *eventLog {
+		exitCode := 0
+		for _, demoPath := range demoPaths {
+			if code, err := logEvents(demoPath, out, anon); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", demoPath, err)
+				if exitCode == 0 {
+					exitCode = code
+				}
 			}
+		}
+		os.Exit(exitCode)
+	}
 
-			enemies := 0
-			for _, m := range p.GameState().Team(enemyTeam).Members() {
-				if m.IsAlive() {
-					enemies++
+	// Text scoreboard for interactive use, one block per demo
+	if *scoreboard {
+		exitCode := 0
+		for i, demoPath := range demoPaths {
+			result, code := parse(demoPath, opts)
+			if result.Error != "" {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", demoPath, result.Error)
+				if exitCode == 0 {
+					exitCode = code
 				}
+				continue
+			}
+			if i > 0 {
+				fmt.Fprintln(out)
 			}
-			clutchOpponents = enemies
-		} else if aliveCount == 0 {
-			// Team wiped, clutch failed if it was pending for this team
-			if potentialClutcher != nil && potentialClutcher.Team == victimTeam {
-				potentialClutcher = nil // Failed
+			if multiFile {
+				fmt.Fprintln(out, demoPath)
 			}
+			writeScoreboard(out, result)
 		}
-	})
-
-	p.RegisterEventHandler(func(e events.PlayerHurt) {
-		if !p.GameState().IsMatchStarted() {
-			return
+		if exitCode != 0 {
+			os.Exit(exitCode)
 		}
-		if e.Attacker != nil {
-			s := getStats(e.Attacker)
-			if s != nil {
-				s.Damage += e.HealthDamage
+		return
+	}
 
-				// Utility Damage
-				if e.Weapon != nil && (e.Weapon.Type == common.EqMolotov || e.Weapon.Type == common.EqIncendiary || e.Weapon.Type == common.EqHE) {
-					s.UtilityDamage += e.HealthDamage
+	// Aggregate: sum every demo's raw counters per player and derive the
+	// rates once, over the rounds of the matches each player was in
+	if *aggregate {
+		demoOpts := opts
+		demoOpts.Top = 0 // Cut the career list, not each demo's
+		career := make(map[uint64]*demostats.PlayerStats)
+		agg := AggregateResult{SchemaVersion: demostats.SchemaVersion}
+		exitCode := 0
+		for _, demoPath := range demoPaths {
+			result, code := parse(demoPath, demoOpts)
+			if result.Error != "" {
+				if exitCode == 0 {
+					exitCode = code
+				}
+				agg.Failed = append(agg.Failed, fmt.Sprintf("%s: %s", demoPath, result.Error))
+				continue
+			}
+			if result.Skipped {
+				continue
+			}
+			agg.Demos++
+			for _, s := range result.Stats {
+				s.Matches = 1
+				if career[s.SteamID] == nil {
+					career[s.SteamID] = &demostats.PlayerStats{}
 				}
+				demostats.AddStats(career[s.SteamID], &s)
 			}
 		}
-	})
+		agg.Stats = demostats.FinalizeStats(career, 0, opts)
+		mustEncode(encoder, agg)
+		os.Exit(exitCode)
+	}
 
-	p.RegisterEventHandler(func(e events.PlayerFlashed) {
-		if !p.GameState().IsMatchStarted() {
-			return
-		}
-		// PlayerFlashed: e.Player (victim), e.Attacker (thrower)
-		if e.Attacker != nil && e.Player != nil && e.Attacker.Team != e.Player.Team {
-			s := getStats(e.Attacker)
-			if s != nil {
-				s.Flashed++
+	// Protobuf: one MatchResult message, or with several demos a stream of
+	// length-delimited messages written as each demo finishes
+	if *format == "protobuf" {
+		exitCode := 0
+		for _, demoPath := range demoPaths {
+			result, code := parse(demoPath, opts)
+			if exitCode == 0 {
+				exitCode = code
+			}
+			if quietFailure(demoPath, result.Error) {
+				continue
+			}
+			if multiFile {
+				result.File = demoPath
+			}
+			msg, err := protoMarshal(result)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: Error encoding protobuf: %v\n", demoPath, err)
+				if exitCode == 0 {
+					exitCode = exitParseFailure
+				}
+				continue
 			}
-		} else if e.Attacker != nil && e.Player != nil && e.Attacker.Team == e.Player.Team {
-			// Team flash
-			s := getStats(e.Attacker)
-			if s != nil {
-				s.TeamFlashed++
+			if !multiFile {
+				out.Write(msg)
+				continue
 			}
+			out.Write(protowire.AppendVarint(nil, uint64(len(msg))))
+			out.Write(msg)
 		}
-	})
-
-	p.RegisterEventHandler(func(e events.BombPlanted) {
-		if !p.GameState().IsMatchStarted() {
-			return
+		if exitCode != 0 {
+			os.Exit(exitCode)
 		}
-		s := getStats(e.Player)
-		if s != nil {
-			s.BombPlants++
+		return
+	}
+
+	// Single file: one object, as before
+	if !multiFile {
+		result, code := parse(demoPaths[0], opts)
+		if result.Error != "" {
+			writeError(out, result.Error, code)
 		}
-	})
+		mustEncode(encoder, result)
+		return
+	}
 
-	p.RegisterEventHandler(func(e events.BombDefused) {
-		if !p.GameState().IsMatchStarted() {
-			return
+	// Multi-file mode: a JSON array, or NDJSON streamed per demo.
+	// Stdout is unbuffered so each line is flushed as soon as it's encoded.
+	results := []demostats.MatchResult{}
+	exitCode := 0
+	for _, demoPath := range demoPaths {
+		result, code := parse(demoPath, opts)
+		result.File = demoPath
+		if exitCode == 0 {
+			exitCode = code
 		}
-		s := getStats(e.Player)
-		if s != nil {
-			s.BombDefuses++
+		if quietFailure(demoPath, result.Error) {
+			continue
 		}
-	})
-
-	// Match Start / Round tracking for ADR
-	p.RegisterEventHandler(func(e events.RoundEnd) {
-		if !p.GameState().IsMatchStarted() {
-			return
+		if *ndjson {
+			mustEncode(encoder, result)
+			continue
 		}
-		totalRounds++
+		results = append(results, result)
+	}
+	if !*ndjson {
+		mustEncode(encoder, results)
+	}
+	os.Exit(exitCode)
+}
 
-		// Process Multi-Kills
-		for steamID, kills := range roundKills {
-			if kills > 0 {
-				s := stats[steamID]
-				if s != nil {
-					s.MultiKills[kills]++
-				}
-			}
+// anonymizer hands out pseudonyms for -anonymize: the Nth player seen
+// becomes "PlayerN" with SteamID N. A nil anonymizer changes nothing.
+type anonymizer struct {
+	ids map[uint64]uint64
+}
+
+// id returns steamID's pseudonymous ID, 0 (nobody) stays 0
+func (a *anonymizer) id(steamID uint64) uint64 {
+	if a == nil || steamID == 0 {
+		return steamID
+	}
+	if _, ok := a.ids[steamID]; !ok {
+		a.ids[steamID] = uint64(len(a.ids) + 1)
+	}
+	return a.ids[steamID]
+}
+
+// apply replaces every name and SteamID in result. The scoreboard goes
+// first so pseudonyms follow its order.
+func (a *anonymizer) apply(result *demostats.MatchResult) {
+	if a == nil {
+		return
+	}
+	for _, list := range [][]demostats.PlayerStats{result.Stats, result.StatsFirstHalf, result.StatsSecondHalf} {
+		for i := range list {
+			list[i].SteamID = a.id(list[i].SteamID)
+			list[i].Player = fmt.Sprintf("Player%d", list[i].SteamID)
+			list[i].AllNames = []string{list[i].Player}
+		}
+	}
+	for i := range result.Killfeed {
+		ke := &result.Killfeed[i]
+		ke.Killer, ke.Victim, ke.Assister = a.id(ke.Killer), a.id(ke.Victim), a.id(ke.Assister)
+	}
+	for i := range result.Rounds {
+		round := &result.Rounds[i]
+		round.RoundMVP = a.id(round.RoundMVP)
+		for j := range round.Survivors {
+			round.Survivors[j].SteamID = a.id(round.Survivors[j].SteamID)
+			round.Survivors[j].Player = fmt.Sprintf("Player%d", round.Survivors[j].SteamID)
 		}
+	}
+	for i := range result.OpeningDuels {
+		duel := &result.OpeningDuels[i]
+		duel.Winner, duel.Loser = a.id(duel.Winner), a.id(duel.Loser)
+	}
+}
 
-		// Process Clutch
-		// If we hava a potential clutcher AND his team won
-		if potentialClutcher != nil && potentialClutcher.Team == e.Winner {
-			// Validate: Clutches are usually 1v1, 1v2 etc.
-			// If clutchOpponents >= 1, it's a clutch
-			if clutchOpponents >= 1 {
-				s := getStats(potentialClutcher)
-				if s != nil {
-					s.ClutchWins++
-				}
-			}
+// parseDemo parses a single demo file into a MatchResult, hashing it too
+// if hash is set. Failures are reported through the result's Error field,
+// with the exit code to use.
+func parseDemo(demoPath string, opts demostats.Options, hash bool) (demostats.MatchResult, int) {
+	f, err := openDemo(demoPath)
+	if err != nil {
+		return demostats.MatchResult{SchemaVersion: demostats.SchemaVersion, Error: fmt.Sprintf("Error opening file: %v", err)}, exitOpenFailure
+	}
+	defer f.Close()
+
+	// Parsing can stop early (-max-round), read the rest so the hash
+	// always covers the whole demo
+	var r io.Reader = f
+	h := sha256.New()
+	if hash {
+		r = io.TeeReader(f, h)
+	}
+	result, err := demostats.Parse(r, opts, nil)
+	if err != nil {
+		return demostats.MatchResult{SchemaVersion: demostats.SchemaVersion, Error: fmt.Sprintf("Error parsing demo: %v", err)}, exitParseFailure
+	}
+	// Skipped demos aren't worth reading the rest of
+	if hash && !result.Skipped {
+		if _, err := io.Copy(io.Discard, r); err == nil {
+			result.DemoHash = hex.EncodeToString(h.Sum(nil))
 		}
-	})
+	}
+	return result, 0
+}
 
-	// Parse to end
-	err = p.ParseToEnd()
+// readHeader parses only demoPath's header for -header. Returns the exit
+// code to use on failure.
+func readHeader(demoPath string) (demostats.HeaderResult, int) {
+	f, err := openDemo(demoPath)
 	if err != nil {
-		outputError(fmt.Sprintf("Error parsing demo: %v", err))
-		return
+		return demostats.HeaderResult{File: demoPath, Error: fmt.Sprintf("Error opening file: %v", err)}, exitOpenFailure
 	}
+	defer f.Close()
 
-	// Finalizing Data
-	gameState := p.GameState()
-	tTeam := gameState.Team(common.TeamTerrorists)
-	ctTeam := gameState.Team(common.TeamCounterTerrorists)
+	result, err := demostats.ReadHeader(f)
+	result.File = demoPath
+	if err != nil {
+		result.Error = fmt.Sprintf("Error parsing demo: %v", err)
+		return result, exitParseFailure
+	}
+	return result, 0
+}
 
-	if tTeam != nil {
-		scoreT = tTeam.Score()
+// validateDemo checks that demoPath parses for -validate. Returns the exit
+// code to use on failure.
+func validateDemo(demoPath string) (demostats.ValidationResult, int) {
+	f, err := openDemo(demoPath)
+	if err != nil {
+		return demostats.ValidationResult{File: demoPath, Error: fmt.Sprintf("Error opening file: %v", err)}, exitOpenFailure
 	}
-	if ctTeam != nil {
-		scoreCT = ctTeam.Score()
+	defer f.Close()
+
+	result, err := demostats.Validate(f)
+	result.File = demoPath
+	if err != nil {
+		result.Error = fmt.Sprintf("Error parsing demo: %v", err)
+		return result, exitParseFailure
 	}
+	return result, 0
+}
 
-	scoreStr := fmt.Sprintf("T %d - %d CT", scoreT, scoreCT)
+// logEvents streams the key events of demoPath to w as NDJSON for -events.
+// SteamIDs go through anon, which may be nil. Returns the exit code to use
+// on failure.
+func logEvents(demoPath string, w io.Writer, anon *anonymizer) (int, error) {
+	f, err := openDemo(demoPath)
+	if err != nil {
+		return exitOpenFailure, fmt.Errorf("Error opening file: %v", err)
+	}
+	defer f.Close()
 
-	// Check header for map
-	header := p.Header()
-	mapName := header.MapName
-	if strings.HasPrefix(mapName, "de_") {
-		mapName = strings.Title(mapName[3:])
+	encoder := json.NewEncoder(w)
+	err = demostats.Events(f, func(ev demostats.LoggedEvent) {
+		ev.Player, ev.Attacker, ev.Assister = anon.id(ev.Player), anon.id(ev.Attacker), anon.id(ev.Assister)
+		encoder.Encode(ev)
+	})
+	if err != nil {
+		return exitParseFailure, fmt.Errorf("Error parsing demo: %v", err)
 	}
+	return 0, nil
+}
 
-	// Process stats map into slice
-	var statsList []PlayerStats
-	for _, s := range stats {
-		// Calculate derived stats
-		if s.Kills > 0 {
-			s.HSPercent = (float64(s.Headshots) / float64(s.Kills)) * 100
+// version is set for releases with -ldflags "-X main.version=v1.2.3".
+// Otherwise versionString falls back to what the build info knows.
+var version = "dev"
+
+// versionString describes this build: its version or VCS revision, the
+// output schema and the demoinfocs library it was built against.
+// A plain "go build main.go" has neither a module version nor a revision.
+func versionString() string {
+	v, library := version, "unknown"
+	if info, ok := runtimedebug.ReadBuildInfo(); ok {
+		if v == "dev" {
+			if mv := info.Main.Version; mv != "" && mv != "(devel)" {
+				v = mv
+			}
+			for _, setting := range info.Settings {
+				if setting.Key == "vcs.revision" {
+					v += " " + setting.Value
+				}
+			}
 		}
-		if s.Deaths == 0 {
-			s.KD = float64(s.Kills)
-		} else {
-			s.KD = float64(s.Kills) / float64(s.Deaths)
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/markus-wa/demoinfocs-golang/v4" {
+				library = dep.Version
+			}
 		}
-		if totalRounds > 0 {
-			s.ADR = float64(s.Damage) / float64(totalRounds)
+	}
+	return fmt.Sprintf("go_parser %s (schema %d)\ndemoinfocs-golang %s", v, demostats.SchemaVersion, library)
+}
+
+// writeScoreboard prints result like the in-game scoreboard: the score,
+// then each team's players in scoreboard order.
+func writeScoreboard(w io.Writer, result demostats.MatchResult) {
+	fmt.Fprintf(w, "%s  %s\n", result.MapName, result.ScoreStr)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, team := range []struct {
+		num   common.Team
+		name  string
+		score int
+	}{
+		{common.TeamTerrorists, "T", result.ScoreT},
+		{common.TeamCounterTerrorists, "CT", result.ScoreCT},
+	} {
+		fmt.Fprintf(tw, "\n%s (%d)\tK\tA\tD\tADR\tHS%%\tKAST\tRating\t\n", team.name, team.score)
+		for _, s := range result.Stats {
+			if s.TeamNum != int(team.num) {
+				continue
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.1f\t%.1f\t%.1f\t%.2f\t\n", s.Player, s.Kills, s.Assists, s.Deaths, s.ADR, s.HSPercent, s.KAST, s.Rating)
+		}
+	}
+	tw.Flush()
+}
+
+// jsonSchema describes t as a JSON Schema, following the json tags the
+// same way encoding/json does. Fields without omitempty are required.
+func jsonSchema(t reflect.Type) map[string]any {
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Pointer:
+		return jsonSchema(t.Elem())
+	case reflect.Slice:
+		return map[string]any{"type": []string{"array", "null"}, "items": jsonSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": []string{"object", "null"}, "additionalProperties": jsonSchema(t.Elem())}
+	case reflect.Struct:
+		properties := make(map[string]any)
+		required := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := field.Tag.Get("json")
+			if !field.IsExported() || tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			if name == "" {
+				name = field.Name
+			}
+			properties[name] = jsonSchema(field.Type)
+			if opts != "omitempty" {
+				required = append(required, name)
+			}
 		}
-		// Rounding
-		s.KD = float64(int(s.KD*100)) / 100
-		s.HSPercent = float64(int(s.HSPercent*10)) / 10
-		s.ADR = float64(int(s.ADR*10)) / 10
+		return map[string]any{"type": "object", "properties": properties, "required": required}
+	}
+	return map[string]any{}
+}
 
-		statsList = append(statsList, *s)
+// matchProto is the definition of the -format protobuf output, printed by
+// -proto
+//
+//go:embed matchpb/match.proto
+var matchProto string
+
+// protoMarshal encodes result as a matchpb.MatchResult. Map entries are
+// written in key order, so the same demo always gives the same bytes.
+func protoMarshal(result demostats.MatchResult) ([]byte, error) {
+	msg := &matchpb.MatchResult{}
+	protoFill(msg.ProtoReflect(), reflect.ValueOf(result))
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
+
+// protoFill copies the exported fields of struct v into m, matching them
+// by their snake_case name. json:"-" fields are never written, and zero
+// values are left out like proto3 does.
+func protoFill(m protoreflect.Message, v reflect.Value) {
+	t := v.Type()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Tag.Get("json") == "-" {
+			continue
+		}
+		fd := fields.ByName(protoreflect.Name(snakeCase(field.Name)))
+		if fd == nil {
+			continue // TestProtoCoversOutput keeps match.proto complete
+		}
+		fv := v.Field(i)
+		switch {
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			for j := 0; j < fv.Len(); j++ {
+				if fd.Message() != nil {
+					protoFill(list.AppendMutable().Message(), fv.Index(j))
+				} else {
+					list.Append(protoScalar(fv.Index(j)))
+				}
+			}
+		case fd.IsMap():
+			entries := m.Mutable(fd).Map()
+			iter := fv.MapRange()
+			for iter.Next() {
+				key := protoScalar(iter.Key()).MapKey()
+				if fd.MapValue().Message() != nil {
+					protoFill(entries.Mutable(key).Message(), iter.Value())
+				} else {
+					entries.Set(key, protoScalar(iter.Value()))
+				}
+			}
+		case fd.Message() != nil:
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			protoFill(m.Mutable(fd).Message(), fv)
+		default:
+			if !fv.IsZero() {
+				m.Set(fd, protoScalar(fv))
+			}
+		}
 	}
+}
 
-	// Sort by Kills or Score (Naive Score: K*2 + A*1 + D*-1? Or just damage. User had Score from game)
-	// We didn't track "Score" explicitly from GameState, but we could have read it from p.GameState().Participants()
-	// Let's do a pass using Participants() for final Score/Money if possible,
-	// BUT Participants() refers to current state at end of demo.
-	// Best to rely on accumulated stats or capture Score from Participants at end.
+// protoScalar converts a Go scalar to its proto value. Strings are made
+// valid UTF-8 the way encoding/json does, proto3 rejects anything else.
+func protoScalar(v reflect.Value) protoreflect.Value {
+	switch v.Kind() {
+	case reflect.Bool:
+		return protoreflect.ValueOfBool(v.Bool())
+	case reflect.Int, reflect.Int64:
+		return protoreflect.ValueOfInt64(v.Int())
+	case reflect.Uint64:
+		return protoreflect.ValueOfUint64(v.Uint())
+	case reflect.Float64:
+		return protoreflect.ValueOfFloat64(v.Float())
+	case reflect.String:
+		return protoreflect.ValueOfString(strings.ToValidUTF8(v.String(), "\uFFFD"))
+	}
+	panic(fmt.Sprintf("no protobuf type for %s", v.Type()))
+}
 
-	for _, participant := range gameState.Participants().All() {
-		s := getStats(participant)
-		if s != nil {
-			s.Score = participant.Score()
-			// s.TotalSpent is tricky, might need to track ItemPickup or similar event, or MoneySpent event if available
+// snakeCase turns a Go field name like "HSPercent" into "hs_percent"
+func snakeCase(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		upper := r >= 'A' && r <= 'Z'
+		if upper && i > 0 {
+			prevLower := name[i-1] >= 'a' && name[i-1] <= 'z' || name[i-1] >= '0' && name[i-1] <= '9'
+			nextLower := i+1 < len(name) && name[i+1] >= 'a' && name[i+1] <= 'z'
+			if prevLower || (nextLower && name[i-1] >= 'A' && name[i-1] <= 'Z') {
+				sb.WriteByte('_')
+			}
 		}
+		if upper {
+			r += 'a' - 'A'
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// mustEncode writes v as the next JSON value of the output, exiting with
+// exitOutputFailure if it can't be encoded or written
+func mustEncode(encoder *json.Encoder, v any) {
+	if err := encoder.Encode(v); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
+		os.Exit(exitOutputFailure)
+	}
+}
+
+// quietFailure reports a failed demo's error on stderr with -quiet, where it
+// is left out of the output, and says whether it did
+func quietFailure(demoPath, errMsg string) bool {
+	if !quiet || errMsg == "" {
+		return false
 	}
+	fmt.Fprintf(os.Stderr, "%s: %s\n", demoPath, errMsg)
+	return true
+}
+
+// outputError reports a fatal error as error JSON on stdout, or with -quiet
+// as plain text on stderr, and exits with code
+func outputError(msg string, code int) { writeError(os.Stdout, msg, code) }
 
-	// Sort
-	sort.Slice(statsList, func(i, j int) bool {
-		return statsList[i].Score > statsList[j].Score // Descending
+// writeError is outputError with the error JSON going to w, e.g. the -o file
+func writeError(w io.Writer, msg string, code int) {
+	if quiet {
+		fmt.Fprintln(os.Stderr, msg)
+		os.Exit(code)
+	}
+	json.NewEncoder(w).Encode(demostats.MatchResult{
+		SchemaVersion: demostats.SchemaVersion,
+		Error:         msg,
 	})
+	os.Exit(code)
+}
+
+// Webhook delivery: each attempt gets webhookTimeout, and failed attempts
+// are retried after 1s, 2s, ... up to webhookAttempts in total.
+const (
+	webhookAttempts = 3
+	webhookTimeout  = 30 * time.Second
+)
 
-	result := MatchResult{
-		ScoreStr: scoreStr,
-		Stats:    statsList,
-		MapName:  mapName,
-		ScoreT:   scoreT,
-		ScoreCT:  scoreCT,
+// postWebhook POSTs result as JSON to url, with file set to demoPath even
+// for a single demo. Receivers can use demo_hash to ignore results they've
+// already seen when a retry follows a lost response.
+func postWebhook(url, demoPath string, result demostats.MatchResult) error {
+	result.File = demoPath
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
 	}
+	client := &http.Client{Timeout: webhookTimeout}
+	for attempt := 1; ; attempt++ {
+		var resp *http.Response
+		resp, err = client.Post(url, "application/json", bytes.NewReader(body))
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode/100 == 2 {
+				return nil
+			}
+			err = fmt.Errorf("%s", resp.Status)
+		}
+		if attempt == webhookAttempts {
+			return err
+		}
+		demostats.Debug.Printf("webhook attempt %d failed: %v", attempt, err)
+		time.Sleep(time.Duration(attempt) * time.Second)
+	}
+}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.Encode(result)
+// downloadTimeout caps how long fetching a demo from a URL may take
+const downloadTimeout = 10 * time.Minute
+
+// openDemo opens a demo file or http(s) URL, transparently decompressing
+// it if needed. Downloads are streamed straight into the parser.
+func openDemo(path string) (io.ReadCloser, error) {
+	var f io.ReadCloser
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		client := &http.Client{Timeout: downloadTimeout}
+		resp, err := client.Get(path)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("download failed: %s", resp.Status)
+		}
+		f = resp.Body
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		f = file
+	}
+	r, err := decompress(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{r, f}, nil
 }
 
-func outputError(msg string) {
-	json.NewEncoder(os.Stdout).Encode(MatchResult{
-		Error: msg,
+// findDemos lists the demos in dir, including subdirectories if recursive,
+// in lexical order.
+func findDemos(dir string, recursive bool) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		name := strings.ToLower(d.Name())
+		for _, ext := range []string{".dem", ".dem.gz", ".dem.bz2"} {
+			if strings.HasSuffix(name, ext) {
+				paths = append(paths, path)
+				break
+			}
+		}
+		return nil
 	})
+	return paths, err
+}
+
+// decompress wraps r in a gzip or bzip2 reader based on its magic bytes.
+// Plain demos are passed through.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(3)
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, []byte("BZh")):
+		return bzip2.NewReader(br), nil
+	}
+	return br, nil
 }
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go_parser/demostats"
+)
+
+// A demo skipped by -only-maps isn't hashed for -webhook
+func TestParseDemoSkipsHash(t *testing.T) {
+	opts := demostats.DefaultOptions()
+	opts.OnlyMaps = map[string]bool{"de_dust2": true}
+	result, code := parseDemo("demostats/testdata/header.dem", opts, true)
+	if code != 0 || !result.Skipped || result.DemoHash != "" {
+		t.Errorf("got exit code %d, Skipped %v, DemoHash %q, want skipped without a hash", code, result.Skipped, result.DemoHash)
+	}
+}
+
+// Demos that can't be parsed report the parse failure exit code
+func TestParseDemoBroken(t *testing.T) {
+	result, code := parseDemo("go.mod", demostats.DefaultOptions(), false)
+	if result.Error == "" || code != exitParseFailure {
+		t.Errorf("Error = %q, exit code %d, want an error and %d", result.Error, code, exitParseFailure)
+	}
+	if result, code := readHeader("demostats/testdata/header.dem"); code != 0 || result.Error != "" || result.File == "" {
+		t.Errorf("valid header: Error = %q, exit code %d, File %q", result.Error, code, result.File)
+	}
+}
+
+// The webhook payload names the demo even when the output wouldn't
+func TestPostWebhookSendsFile(t *testing.T) {
+	var got demostats.MatchResult
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	if err := postWebhook(srv.URL, "demos/match.dem", demostats.MatchResult{SchemaVersion: demostats.SchemaVersion, MapName: "Test"}); err != nil {
+		t.Fatal(err)
+	}
+	if got.File != "demos/match.dem" || got.MapName != "Test" {
+		t.Errorf("posted File %q, MapName %q, want demos/match.dem, Test", got.File, got.MapName)
+	}
+}
@@ -0,0 +1,2735 @@
+// Protobuf form of the parser output (-format protobuf). The messages
+// mirror the JSON output, with field names in snake_case.
+//
+// Field numbers are part of the wire format: give a new field the next
+// free number and never renumber or reuse one. Regenerate match.pb.go
+// with protoc-gen-go after editing.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: match.proto
+
+package matchpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MultiKillRound struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int64                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Kills         int64                  `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiKillRound) Reset() {
+	*x = MultiKillRound{}
+	mi := &file_match_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiKillRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiKillRound) ProtoMessage() {}
+
+func (x *MultiKillRound) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiKillRound.ProtoReflect.Descriptor instead.
+func (*MultiKillRound) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MultiKillRound) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *MultiKillRound) GetKills() int64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+type WeaponStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kills         int64                  `protobuf:"varint,1,opt,name=kills,proto3" json:"kills,omitempty"`
+	Headshots     int64                  `protobuf:"varint,2,opt,name=headshots,proto3" json:"headshots,omitempty"`
+	ShotsFired    int64                  `protobuf:"varint,3,opt,name=shots_fired,json=shotsFired,proto3" json:"shots_fired,omitempty"`
+	ShotsHit      int64                  `protobuf:"varint,4,opt,name=shots_hit,json=shotsHit,proto3" json:"shots_hit,omitempty"`
+	Accuracy      float64                `protobuf:"fixed64,5,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	HsPercent     float64                `protobuf:"fixed64,6,opt,name=hs_percent,json=hsPercent,proto3" json:"hs_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeaponStat) Reset() {
+	*x = WeaponStat{}
+	mi := &file_match_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeaponStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponStat) ProtoMessage() {}
+
+func (x *WeaponStat) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponStat.ProtoReflect.Descriptor instead.
+func (*WeaponStat) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WeaponStat) GetKills() int64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *WeaponStat) GetHeadshots() int64 {
+	if x != nil {
+		return x.Headshots
+	}
+	return 0
+}
+
+func (x *WeaponStat) GetShotsFired() int64 {
+	if x != nil {
+		return x.ShotsFired
+	}
+	return 0
+}
+
+func (x *WeaponStat) GetShotsHit() int64 {
+	if x != nil {
+		return x.ShotsHit
+	}
+	return 0
+}
+
+func (x *WeaponStat) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *WeaponStat) GetHsPercent() float64 {
+	if x != nil {
+		return x.HsPercent
+	}
+	return 0
+}
+
+type PlayerStats struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Player                string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	AllNames              []string               `protobuf:"bytes,2,rep,name=all_names,json=allNames,proto3" json:"all_names,omitempty"`
+	SteamId               uint64                 `protobuf:"varint,3,opt,name=steam_id,json=steamId,proto3" json:"steam_id,omitempty"`
+	TeamNum               int64                  `protobuf:"varint,4,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	Connected             bool                   `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+	Kills                 int64                  `protobuf:"varint,6,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths                int64                  `protobuf:"varint,7,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists               int64                  `protobuf:"varint,8,opt,name=assists,proto3" json:"assists,omitempty"`
+	Kd                    float64                `protobuf:"fixed64,9,opt,name=kd,proto3" json:"kd,omitempty"`
+	Adr                   float64                `protobuf:"fixed64,10,opt,name=adr,proto3" json:"adr,omitempty"`
+	HsPercent             float64                `protobuf:"fixed64,11,opt,name=hs_percent,json=hsPercent,proto3" json:"hs_percent,omitempty"`
+	Rating                float64                `protobuf:"fixed64,12,opt,name=rating,proto3" json:"rating,omitempty"`
+	Score                 int64                  `protobuf:"varint,13,opt,name=score,proto3" json:"score,omitempty"`
+	Rank                  int64                  `protobuf:"varint,14,opt,name=rank,proto3" json:"rank,omitempty"`
+	Damage                int64                  `protobuf:"varint,15,opt,name=damage,proto3" json:"damage,omitempty"`
+	UtilityDamage         int64                  `protobuf:"varint,16,opt,name=utility_damage,json=utilityDamage,proto3" json:"utility_damage,omitempty"`
+	HeDamage              int64                  `protobuf:"varint,17,opt,name=he_damage,json=heDamage,proto3" json:"he_damage,omitempty"`
+	FireDamage            int64                  `protobuf:"varint,18,opt,name=fire_damage,json=fireDamage,proto3" json:"fire_damage,omitempty"`
+	InfernoTickDamage     int64                  `protobuf:"varint,19,opt,name=inferno_tick_damage,json=infernoTickDamage,proto3" json:"inferno_tick_damage,omitempty"`
+	SelfDamage            int64                  `protobuf:"varint,20,opt,name=self_damage,json=selfDamage,proto3" json:"self_damage,omitempty"`
+	DamageTaken           int64                  `protobuf:"varint,21,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
+	TeamDamage            int64                  `protobuf:"varint,22,opt,name=team_damage,json=teamDamage,proto3" json:"team_damage,omitempty"`
+	GrenadesThrown        map[string]int64       `protobuf:"bytes,23,rep,name=grenades_thrown,json=grenadesThrown,proto3" json:"grenades_thrown,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	UtilityValueSpent     int64                  `protobuf:"varint,24,opt,name=utility_value_spent,json=utilityValueSpent,proto3" json:"utility_value_spent,omitempty"`
+	UtilityPerRound       float64                `protobuf:"fixed64,25,opt,name=utility_per_round,json=utilityPerRound,proto3" json:"utility_per_round,omitempty"`
+	SmokesThrown          int64                  `protobuf:"varint,26,opt,name=smokes_thrown,json=smokesThrown,proto3" json:"smokes_thrown,omitempty"`
+	MolotovsThrown        int64                  `protobuf:"varint,27,opt,name=molotovs_thrown,json=molotovsThrown,proto3" json:"molotovs_thrown,omitempty"`
+	FireAreaDenialTime    float64                `protobuf:"fixed64,28,opt,name=fire_area_denial_time,json=fireAreaDenialTime,proto3" json:"fire_area_denial_time,omitempty"`
+	Flashed               int64                  `protobuf:"varint,29,opt,name=flashed,proto3" json:"flashed,omitempty"`
+	TeamFlashed           int64                  `protobuf:"varint,30,opt,name=team_flashed,json=teamFlashed,proto3" json:"team_flashed,omitempty"`
+	FlashEfficiency       float64                `protobuf:"fixed64,31,opt,name=flash_efficiency,json=flashEfficiency,proto3" json:"flash_efficiency,omitempty"`
+	AvgEnemyBlindPerFlash float64                `protobuf:"fixed64,32,opt,name=avg_enemy_blind_per_flash,json=avgEnemyBlindPerFlash,proto3" json:"avg_enemy_blind_per_flash,omitempty"`
+	FlashAssists          int64                  `protobuf:"varint,33,opt,name=flash_assists,json=flashAssists,proto3" json:"flash_assists,omitempty"`
+	DamageAssists         int64                  `protobuf:"varint,34,opt,name=damage_assists,json=damageAssists,proto3" json:"damage_assists,omitempty"`
+	TotalSpent            int64                  `protobuf:"varint,35,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	DamagePerDollar       float64                `protobuf:"fixed64,36,opt,name=damage_per_dollar,json=damagePerDollar,proto3" json:"damage_per_dollar,omitempty"`
+	KillsPer1000          float64                `protobuf:"fixed64,37,opt,name=kills_per1000,json=killsPer1000,proto3" json:"kills_per1000,omitempty"`
+	AvgStartMoney         float64                `protobuf:"fixed64,38,opt,name=avg_start_money,json=avgStartMoney,proto3" json:"avg_start_money,omitempty"`
+	AvgEquipmentValue     float64                `protobuf:"fixed64,39,opt,name=avg_equipment_value,json=avgEquipmentValue,proto3" json:"avg_equipment_value,omitempty"`
+	EntryKills            int64                  `protobuf:"varint,40,opt,name=entry_kills,json=entryKills,proto3" json:"entry_kills,omitempty"`
+	EntryDeaths           int64                  `protobuf:"varint,41,opt,name=entry_deaths,json=entryDeaths,proto3" json:"entry_deaths,omitempty"`
+	OpeningImpact         float64                `protobuf:"fixed64,42,opt,name=opening_impact,json=openingImpact,proto3" json:"opening_impact,omitempty"`
+	TimeToFirstKill       float64                `protobuf:"fixed64,43,opt,name=time_to_first_kill,json=timeToFirstKill,proto3" json:"time_to_first_kill,omitempty"`
+	TimesEntryTraded      int64                  `protobuf:"varint,44,opt,name=times_entry_traded,json=timesEntryTraded,proto3" json:"times_entry_traded,omitempty"`
+	OpeningWinRate        float64                `protobuf:"fixed64,45,opt,name=opening_win_rate,json=openingWinRate,proto3" json:"opening_win_rate,omitempty"`
+	OpeningWinRateT       float64                `protobuf:"fixed64,46,opt,name=opening_win_rate_t,json=openingWinRateT,proto3" json:"opening_win_rate_t,omitempty"`
+	OpeningWinRateCt      float64                `protobuf:"fixed64,47,opt,name=opening_win_rate_ct,json=openingWinRateCt,proto3" json:"opening_win_rate_ct,omitempty"`
+	FirstDeaths           int64                  `protobuf:"varint,48,opt,name=first_deaths,json=firstDeaths,proto3" json:"first_deaths,omitempty"`
+	TimesLastAlive        int64                  `protobuf:"varint,49,opt,name=times_last_alive,json=timesLastAlive,proto3" json:"times_last_alive,omitempty"`
+	Saves                 int64                  `protobuf:"varint,50,opt,name=saves,proto3" json:"saves,omitempty"`
+	ClutchWins            int64                  `protobuf:"varint,51,opt,name=clutch_wins,json=clutchWins,proto3" json:"clutch_wins,omitempty"`
+	TradeKills            int64                  `protobuf:"varint,52,opt,name=trade_kills,json=tradeKills,proto3" json:"trade_kills,omitempty"`
+	Kast                  float64                `protobuf:"fixed64,53,opt,name=kast,proto3" json:"kast,omitempty"`
+	MultiKills            map[int64]int64        `protobuf:"bytes,54,rep,name=multi_kills,json=multiKills,proto3" json:"multi_kills,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	MultiKillRounds       []*MultiKillRound      `protobuf:"bytes,55,rep,name=multi_kill_rounds,json=multiKillRounds,proto3" json:"multi_kill_rounds,omitempty"`
+	MultiTargetRounds     int64                  `protobuf:"varint,56,opt,name=multi_target_rounds,json=multiTargetRounds,proto3" json:"multi_target_rounds,omitempty"`
+	WeaponKills           map[string]int64       `protobuf:"bytes,57,rep,name=weapon_kills,json=weaponKills,proto3" json:"weapon_kills,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	WeaponStats           map[string]*WeaponStat `protobuf:"bytes,58,rep,name=weapon_stats,json=weaponStats,proto3" json:"weapon_stats,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	KillsByCategory       map[string]int64       `protobuf:"bytes,59,rep,name=kills_by_category,json=killsByCategory,proto3" json:"kills_by_category,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ZeusKills             int64                  `protobuf:"varint,60,opt,name=zeus_kills,json=zeusKills,proto3" json:"zeus_kills,omitempty"`
+	CollateralKills       int64                  `protobuf:"varint,61,opt,name=collateral_kills,json=collateralKills,proto3" json:"collateral_kills,omitempty"`
+	JumpKills             int64                  `protobuf:"varint,62,opt,name=jump_kills,json=jumpKills,proto3" json:"jump_kills,omitempty"`
+	TeamKills             int64                  `protobuf:"varint,63,opt,name=team_kills,json=teamKills,proto3" json:"team_kills,omitempty"`
+	AvgKillDistance       float64                `protobuf:"fixed64,64,opt,name=avg_kill_distance,json=avgKillDistance,proto3" json:"avg_kill_distance,omitempty"`
+	MaxKillDistance       float64                `protobuf:"fixed64,65,opt,name=max_kill_distance,json=maxKillDistance,proto3" json:"max_kill_distance,omitempty"`
+	BombPlants            int64                  `protobuf:"varint,66,opt,name=bomb_plants,json=bombPlants,proto3" json:"bomb_plants,omitempty"`
+	BombDefuses           int64                  `protobuf:"varint,67,opt,name=bomb_defuses,json=bombDefuses,proto3" json:"bomb_defuses,omitempty"`
+	BombPickups           int64                  `protobuf:"varint,68,opt,name=bomb_pickups,json=bombPickups,proto3" json:"bomb_pickups,omitempty"`
+	BombDrops             int64                  `protobuf:"varint,69,opt,name=bomb_drops,json=bombDrops,proto3" json:"bomb_drops,omitempty"`
+	PlantedRounds         []int64                `protobuf:"varint,70,rep,packed,name=planted_rounds,json=plantedRounds,proto3" json:"planted_rounds,omitempty"`
+	EnemiesSpotted        int64                  `protobuf:"varint,71,opt,name=enemies_spotted,json=enemiesSpotted,proto3" json:"enemies_spotted,omitempty"`
+	Headshots             int64                  `protobuf:"varint,72,opt,name=headshots,proto3" json:"headshots,omitempty"`
+	Matches               int64                  `protobuf:"varint,73,opt,name=matches,proto3" json:"matches,omitempty"`
+	UtilityAdr            float64                `protobuf:"fixed64,74,opt,name=utility_adr,json=utilityAdr,proto3" json:"utility_adr,omitempty"`
+	SmokeKills            int64                  `protobuf:"varint,75,opt,name=smoke_kills,json=smokeKills,proto3" json:"smoke_kills,omitempty"`
+	OneWayKills           int64                  `protobuf:"varint,76,opt,name=one_way_kills,json=oneWayKills,proto3" json:"one_way_kills,omitempty"`
+	FlashesLeadingToKills int64                  `protobuf:"varint,77,opt,name=flashes_leading_to_kills,json=flashesLeadingToKills,proto3" json:"flashes_leading_to_kills,omitempty"`
+	SmokeAssists          int64                  `protobuf:"varint,78,opt,name=smoke_assists,json=smokeAssists,proto3" json:"smoke_assists,omitempty"`
+	BlindAssists          int64                  `protobuf:"varint,79,opt,name=blind_assists,json=blindAssists,proto3" json:"blind_assists,omitempty"`
+	ValueLostToDeath      int64                  `protobuf:"varint,80,opt,name=value_lost_to_death,json=valueLostToDeath,proto3" json:"value_lost_to_death,omitempty"`
+	EcoKills              int64                  `protobuf:"varint,81,opt,name=eco_kills,json=ecoKills,proto3" json:"eco_kills,omitempty"`
+	ClutchLosses          map[int64]int64        `protobuf:"bytes,82,rep,name=clutch_losses,json=clutchLosses,proto3" json:"clutch_losses,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	OneVOneWins           int64                  `protobuf:"varint,83,opt,name=one_v_one_wins,json=oneVOneWins,proto3" json:"one_v_one_wins,omitempty"`
+	OneVOneLosses         int64                  `protobuf:"varint,84,opt,name=one_v_one_losses,json=oneVOneLosses,proto3" json:"one_v_one_losses,omitempty"`
+	LowHpKills            int64                  `protobuf:"varint,85,opt,name=low_hp_kills,json=lowHpKills,proto3" json:"low_hp_kills,omitempty"`
+	KillsWhenAhead        int64                  `protobuf:"varint,86,opt,name=kills_when_ahead,json=killsWhenAhead,proto3" json:"kills_when_ahead,omitempty"`
+	KillsWhenBehind       int64                  `protobuf:"varint,87,opt,name=kills_when_behind,json=killsWhenBehind,proto3" json:"kills_when_behind,omitempty"`
+	KillsWhenEven         int64                  `protobuf:"varint,88,opt,name=kills_when_even,json=killsWhenEven,proto3" json:"kills_when_even,omitempty"`
+	AvgHpAtKill           float64                `protobuf:"fixed64,89,opt,name=avg_hp_at_kill,json=avgHpAtKill,proto3" json:"avg_hp_at_kill,omitempty"`
+	AvgTimeAlive          float64                `protobuf:"fixed64,90,opt,name=avg_time_alive,json=avgTimeAlive,proto3" json:"avg_time_alive,omitempty"`
+	AggressionIndex       float64                `protobuf:"fixed64,91,opt,name=aggression_index,json=aggressionIndex,proto3" json:"aggression_index,omitempty"`
+	AvgDamageBeforeDeath  float64                `protobuf:"fixed64,92,opt,name=avg_damage_before_death,json=avgDamageBeforeDeath,proto3" json:"avg_damage_before_death,omitempty"`
+	Aces                  int64                  `protobuf:"varint,93,opt,name=aces,proto3" json:"aces,omitempty"`
+	BombKills             int64                  `protobuf:"varint,94,opt,name=bomb_kills,json=bombKills,proto3" json:"bomb_kills,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	mi := &file_match_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlayerStats) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetAllNames() []string {
+	if x != nil {
+		return x.AllNames
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTeamNum() int64 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *PlayerStats) GetKills() int64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDeaths() int64 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAssists() int64 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKd() float64 {
+	if x != nil {
+		return x.Kd
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAdr() float64 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetHsPercent() float64 {
+	if x != nil {
+		return x.HsPercent
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDamage() int64 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetUtilityDamage() int64 {
+	if x != nil {
+		return x.UtilityDamage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetHeDamage() int64 {
+	if x != nil {
+		return x.HeDamage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFireDamage() int64 {
+	if x != nil {
+		return x.FireDamage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetInfernoTickDamage() int64 {
+	if x != nil {
+		return x.InfernoTickDamage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSelfDamage() int64 {
+	if x != nil {
+		return x.SelfDamage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDamageTaken() int64 {
+	if x != nil {
+		return x.DamageTaken
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTeamDamage() int64 {
+	if x != nil {
+		return x.TeamDamage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetGrenadesThrown() map[string]int64 {
+	if x != nil {
+		return x.GrenadesThrown
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetUtilityValueSpent() int64 {
+	if x != nil {
+		return x.UtilityValueSpent
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetUtilityPerRound() float64 {
+	if x != nil {
+		return x.UtilityPerRound
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSmokesThrown() int64 {
+	if x != nil {
+		return x.SmokesThrown
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMolotovsThrown() int64 {
+	if x != nil {
+		return x.MolotovsThrown
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFireAreaDenialTime() float64 {
+	if x != nil {
+		return x.FireAreaDenialTime
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFlashed() int64 {
+	if x != nil {
+		return x.Flashed
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTeamFlashed() int64 {
+	if x != nil {
+		return x.TeamFlashed
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFlashEfficiency() float64 {
+	if x != nil {
+		return x.FlashEfficiency
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgEnemyBlindPerFlash() float64 {
+	if x != nil {
+		return x.AvgEnemyBlindPerFlash
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFlashAssists() int64 {
+	if x != nil {
+		return x.FlashAssists
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDamageAssists() int64 {
+	if x != nil {
+		return x.DamageAssists
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTotalSpent() int64 {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDamagePerDollar() float64 {
+	if x != nil {
+		return x.DamagePerDollar
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKillsPer1000() float64 {
+	if x != nil {
+		return x.KillsPer1000
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgStartMoney() float64 {
+	if x != nil {
+		return x.AvgStartMoney
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgEquipmentValue() float64 {
+	if x != nil {
+		return x.AvgEquipmentValue
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetEntryKills() int64 {
+	if x != nil {
+		return x.EntryKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetEntryDeaths() int64 {
+	if x != nil {
+		return x.EntryDeaths
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetOpeningImpact() float64 {
+	if x != nil {
+		return x.OpeningImpact
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTimeToFirstKill() float64 {
+	if x != nil {
+		return x.TimeToFirstKill
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTimesEntryTraded() int64 {
+	if x != nil {
+		return x.TimesEntryTraded
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetOpeningWinRate() float64 {
+	if x != nil {
+		return x.OpeningWinRate
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetOpeningWinRateT() float64 {
+	if x != nil {
+		return x.OpeningWinRateT
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetOpeningWinRateCt() float64 {
+	if x != nil {
+		return x.OpeningWinRateCt
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFirstDeaths() int64 {
+	if x != nil {
+		return x.FirstDeaths
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTimesLastAlive() int64 {
+	if x != nil {
+		return x.TimesLastAlive
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSaves() int64 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetClutchWins() int64 {
+	if x != nil {
+		return x.ClutchWins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTradeKills() int64 {
+	if x != nil {
+		return x.TradeKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKast() float64 {
+	if x != nil {
+		return x.Kast
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMultiKills() map[int64]int64 {
+	if x != nil {
+		return x.MultiKills
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetMultiKillRounds() []*MultiKillRound {
+	if x != nil {
+		return x.MultiKillRounds
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetMultiTargetRounds() int64 {
+	if x != nil {
+		return x.MultiTargetRounds
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetWeaponKills() map[string]int64 {
+	if x != nil {
+		return x.WeaponKills
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetWeaponStats() map[string]*WeaponStat {
+	if x != nil {
+		return x.WeaponStats
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetKillsByCategory() map[string]int64 {
+	if x != nil {
+		return x.KillsByCategory
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetZeusKills() int64 {
+	if x != nil {
+		return x.ZeusKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetCollateralKills() int64 {
+	if x != nil {
+		return x.CollateralKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetJumpKills() int64 {
+	if x != nil {
+		return x.JumpKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTeamKills() int64 {
+	if x != nil {
+		return x.TeamKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgKillDistance() float64 {
+	if x != nil {
+		return x.AvgKillDistance
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMaxKillDistance() float64 {
+	if x != nil {
+		return x.MaxKillDistance
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBombPlants() int64 {
+	if x != nil {
+		return x.BombPlants
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBombDefuses() int64 {
+	if x != nil {
+		return x.BombDefuses
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBombPickups() int64 {
+	if x != nil {
+		return x.BombPickups
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBombDrops() int64 {
+	if x != nil {
+		return x.BombDrops
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetPlantedRounds() []int64 {
+	if x != nil {
+		return x.PlantedRounds
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetEnemiesSpotted() int64 {
+	if x != nil {
+		return x.EnemiesSpotted
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetHeadshots() int64 {
+	if x != nil {
+		return x.Headshots
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMatches() int64 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetUtilityAdr() float64 {
+	if x != nil {
+		return x.UtilityAdr
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSmokeKills() int64 {
+	if x != nil {
+		return x.SmokeKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetOneWayKills() int64 {
+	if x != nil {
+		return x.OneWayKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetFlashesLeadingToKills() int64 {
+	if x != nil {
+		return x.FlashesLeadingToKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSmokeAssists() int64 {
+	if x != nil {
+		return x.SmokeAssists
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBlindAssists() int64 {
+	if x != nil {
+		return x.BlindAssists
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetValueLostToDeath() int64 {
+	if x != nil {
+		return x.ValueLostToDeath
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetEcoKills() int64 {
+	if x != nil {
+		return x.EcoKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetClutchLosses() map[int64]int64 {
+	if x != nil {
+		return x.ClutchLosses
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetOneVOneWins() int64 {
+	if x != nil {
+		return x.OneVOneWins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetOneVOneLosses() int64 {
+	if x != nil {
+		return x.OneVOneLosses
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetLowHpKills() int64 {
+	if x != nil {
+		return x.LowHpKills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKillsWhenAhead() int64 {
+	if x != nil {
+		return x.KillsWhenAhead
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKillsWhenBehind() int64 {
+	if x != nil {
+		return x.KillsWhenBehind
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKillsWhenEven() int64 {
+	if x != nil {
+		return x.KillsWhenEven
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgHpAtKill() float64 {
+	if x != nil {
+		return x.AvgHpAtKill
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgTimeAlive() float64 {
+	if x != nil {
+		return x.AvgTimeAlive
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAggressionIndex() float64 {
+	if x != nil {
+		return x.AggressionIndex
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAvgDamageBeforeDeath() float64 {
+	if x != nil {
+		return x.AvgDamageBeforeDeath
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAces() int64 {
+	if x != nil {
+		return x.Aces
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetBombKills() int64 {
+	if x != nil {
+		return x.BombKills
+	}
+	return 0
+}
+
+type RoundEconomy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Round            int64                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	TEquipmentValue  int64                  `protobuf:"varint,2,opt,name=t_equipment_value,json=tEquipmentValue,proto3" json:"t_equipment_value,omitempty"`
+	CtEquipmentValue int64                  `protobuf:"varint,3,opt,name=ct_equipment_value,json=ctEquipmentValue,proto3" json:"ct_equipment_value,omitempty"`
+	TMoney           int64                  `protobuf:"varint,4,opt,name=t_money,json=tMoney,proto3" json:"t_money,omitempty"`
+	CtMoney          int64                  `protobuf:"varint,5,opt,name=ct_money,json=ctMoney,proto3" json:"ct_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RoundEconomy) Reset() {
+	*x = RoundEconomy{}
+	mi := &file_match_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundEconomy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundEconomy) ProtoMessage() {}
+
+func (x *RoundEconomy) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundEconomy.ProtoReflect.Descriptor instead.
+func (*RoundEconomy) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RoundEconomy) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *RoundEconomy) GetTEquipmentValue() int64 {
+	if x != nil {
+		return x.TEquipmentValue
+	}
+	return 0
+}
+
+func (x *RoundEconomy) GetCtEquipmentValue() int64 {
+	if x != nil {
+		return x.CtEquipmentValue
+	}
+	return 0
+}
+
+func (x *RoundEconomy) GetTMoney() int64 {
+	if x != nil {
+		return x.TMoney
+	}
+	return 0
+}
+
+func (x *RoundEconomy) GetCtMoney() int64 {
+	if x != nil {
+		return x.CtMoney
+	}
+	return 0
+}
+
+type KillEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int64                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Time          float64                `protobuf:"fixed64,2,opt,name=time,proto3" json:"time,omitempty"`
+	Killer        uint64                 `protobuf:"varint,3,opt,name=killer,proto3" json:"killer,omitempty"`
+	Victim        uint64                 `protobuf:"varint,4,opt,name=victim,proto3" json:"victim,omitempty"`
+	Assister      uint64                 `protobuf:"varint,5,opt,name=assister,proto3" json:"assister,omitempty"`
+	Weapon        string                 `protobuf:"bytes,6,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Headshot      bool                   `protobuf:"varint,7,opt,name=headshot,proto3" json:"headshot,omitempty"`
+	Wallbang      bool                   `protobuf:"varint,8,opt,name=wallbang,proto3" json:"wallbang,omitempty"`
+	NoScope       bool                   `protobuf:"varint,9,opt,name=no_scope,json=noScope,proto3" json:"no_scope,omitempty"`
+	ThroughSmoke  bool                   `protobuf:"varint,10,opt,name=through_smoke,json=throughSmoke,proto3" json:"through_smoke,omitempty"`
+	AttackerBlind bool                   `protobuf:"varint,11,opt,name=attacker_blind,json=attackerBlind,proto3" json:"attacker_blind,omitempty"`
+	AssistedFlash bool                   `protobuf:"varint,12,opt,name=assisted_flash,json=assistedFlash,proto3" json:"assisted_flash,omitempty"`
+	Clock         float64                `protobuf:"fixed64,13,opt,name=clock,proto3" json:"clock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillEvent) Reset() {
+	*x = KillEvent{}
+	mi := &file_match_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillEvent) ProtoMessage() {}
+
+func (x *KillEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillEvent.ProtoReflect.Descriptor instead.
+func (*KillEvent) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KillEvent) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *KillEvent) GetTime() float64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *KillEvent) GetKiller() uint64 {
+	if x != nil {
+		return x.Killer
+	}
+	return 0
+}
+
+func (x *KillEvent) GetVictim() uint64 {
+	if x != nil {
+		return x.Victim
+	}
+	return 0
+}
+
+func (x *KillEvent) GetAssister() uint64 {
+	if x != nil {
+		return x.Assister
+	}
+	return 0
+}
+
+func (x *KillEvent) GetWeapon() string {
+	if x != nil {
+		return x.Weapon
+	}
+	return ""
+}
+
+func (x *KillEvent) GetHeadshot() bool {
+	if x != nil {
+		return x.Headshot
+	}
+	return false
+}
+
+func (x *KillEvent) GetWallbang() bool {
+	if x != nil {
+		return x.Wallbang
+	}
+	return false
+}
+
+func (x *KillEvent) GetNoScope() bool {
+	if x != nil {
+		return x.NoScope
+	}
+	return false
+}
+
+func (x *KillEvent) GetThroughSmoke() bool {
+	if x != nil {
+		return x.ThroughSmoke
+	}
+	return false
+}
+
+func (x *KillEvent) GetAttackerBlind() bool {
+	if x != nil {
+		return x.AttackerBlind
+	}
+	return false
+}
+
+func (x *KillEvent) GetAssistedFlash() bool {
+	if x != nil {
+		return x.AssistedFlash
+	}
+	return false
+}
+
+func (x *KillEvent) GetClock() float64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+type RoundSurvivor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        string                 `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	SteamId       uint64                 `protobuf:"varint,2,opt,name=steam_id,json=steamId,proto3" json:"steam_id,omitempty"`
+	TeamNum       int64                  `protobuf:"varint,3,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	Hp            int64                  `protobuf:"varint,4,opt,name=hp,proto3" json:"hp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundSurvivor) Reset() {
+	*x = RoundSurvivor{}
+	mi := &file_match_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundSurvivor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundSurvivor) ProtoMessage() {}
+
+func (x *RoundSurvivor) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundSurvivor.ProtoReflect.Descriptor instead.
+func (*RoundSurvivor) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoundSurvivor) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *RoundSurvivor) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+func (x *RoundSurvivor) GetTeamNum() int64 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+func (x *RoundSurvivor) GetHp() int64 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+type RoundBomb struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Site              string                 `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	PlantTime         float64                `protobuf:"fixed64,2,opt,name=plant_time,json=plantTime,proto3" json:"plant_time,omitempty"`
+	Outcome           string                 `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	DefuseStarts      []float64              `protobuf:"fixed64,4,rep,packed,name=defuse_starts,json=defuseStarts,proto3" json:"defuse_starts,omitempty"`
+	DefuseTime        float64                `protobuf:"fixed64,5,opt,name=defuse_time,json=defuseTime,proto3" json:"defuse_time,omitempty"`
+	DefuseInterrupted bool                   `protobuf:"varint,6,opt,name=defuse_interrupted,json=defuseInterrupted,proto3" json:"defuse_interrupted,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RoundBomb) Reset() {
+	*x = RoundBomb{}
+	mi := &file_match_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundBomb) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundBomb) ProtoMessage() {}
+
+func (x *RoundBomb) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundBomb.ProtoReflect.Descriptor instead.
+func (*RoundBomb) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RoundBomb) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *RoundBomb) GetPlantTime() float64 {
+	if x != nil {
+		return x.PlantTime
+	}
+	return 0
+}
+
+func (x *RoundBomb) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RoundBomb) GetDefuseStarts() []float64 {
+	if x != nil {
+		return x.DefuseStarts
+	}
+	return nil
+}
+
+func (x *RoundBomb) GetDefuseTime() float64 {
+	if x != nil {
+		return x.DefuseTime
+	}
+	return 0
+}
+
+func (x *RoundBomb) GetDefuseInterrupted() bool {
+	if x != nil {
+		return x.DefuseInterrupted
+	}
+	return false
+}
+
+type RoundSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int64                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Winner        int64                  `protobuf:"varint,2,opt,name=winner,proto3" json:"winner,omitempty"`
+	Survivors     []*RoundSurvivor       `protobuf:"bytes,3,rep,name=survivors,proto3" json:"survivors,omitempty"`
+	RoundMvp      uint64                 `protobuf:"varint,4,opt,name=round_mvp,json=roundMvp,proto3" json:"round_mvp,omitempty"`
+	TLossStreak   int64                  `protobuf:"varint,5,opt,name=t_loss_streak,json=tLossStreak,proto3" json:"t_loss_streak,omitempty"`
+	CtLossStreak  int64                  `protobuf:"varint,6,opt,name=ct_loss_streak,json=ctLossStreak,proto3" json:"ct_loss_streak,omitempty"`
+	Phase         string                 `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	Bomb          *RoundBomb             `protobuf:"bytes,8,opt,name=bomb,proto3" json:"bomb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundSummary) Reset() {
+	*x = RoundSummary{}
+	mi := &file_match_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundSummary) ProtoMessage() {}
+
+func (x *RoundSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundSummary.ProtoReflect.Descriptor instead.
+func (*RoundSummary) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RoundSummary) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *RoundSummary) GetWinner() int64 {
+	if x != nil {
+		return x.Winner
+	}
+	return 0
+}
+
+func (x *RoundSummary) GetSurvivors() []*RoundSurvivor {
+	if x != nil {
+		return x.Survivors
+	}
+	return nil
+}
+
+func (x *RoundSummary) GetRoundMvp() uint64 {
+	if x != nil {
+		return x.RoundMvp
+	}
+	return 0
+}
+
+func (x *RoundSummary) GetTLossStreak() int64 {
+	if x != nil {
+		return x.TLossStreak
+	}
+	return 0
+}
+
+func (x *RoundSummary) GetCtLossStreak() int64 {
+	if x != nil {
+		return x.CtLossStreak
+	}
+	return 0
+}
+
+func (x *RoundSummary) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *RoundSummary) GetBomb() *RoundBomb {
+	if x != nil {
+		return x.Bomb
+	}
+	return nil
+}
+
+type LossBonusTotals struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Side              int64                  `protobuf:"varint,1,opt,name=side,proto3" json:"side,omitempty"`
+	BonusRounds       int64                  `protobuf:"varint,2,opt,name=bonus_rounds,json=bonusRounds,proto3" json:"bonus_rounds,omitempty"`
+	MaxBonusRounds    int64                  `protobuf:"varint,3,opt,name=max_bonus_rounds,json=maxBonusRounds,proto3" json:"max_bonus_rounds,omitempty"`
+	LongestLossStreak int64                  `protobuf:"varint,4,opt,name=longest_loss_streak,json=longestLossStreak,proto3" json:"longest_loss_streak,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LossBonusTotals) Reset() {
+	*x = LossBonusTotals{}
+	mi := &file_match_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LossBonusTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LossBonusTotals) ProtoMessage() {}
+
+func (x *LossBonusTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LossBonusTotals.ProtoReflect.Descriptor instead.
+func (*LossBonusTotals) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LossBonusTotals) GetSide() int64 {
+	if x != nil {
+		return x.Side
+	}
+	return 0
+}
+
+func (x *LossBonusTotals) GetBonusRounds() int64 {
+	if x != nil {
+		return x.BonusRounds
+	}
+	return 0
+}
+
+func (x *LossBonusTotals) GetMaxBonusRounds() int64 {
+	if x != nil {
+		return x.MaxBonusRounds
+	}
+	return 0
+}
+
+func (x *LossBonusTotals) GetLongestLossStreak() int64 {
+	if x != nil {
+		return x.LongestLossStreak
+	}
+	return 0
+}
+
+type NadeSpot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float64                `protobuf:"fixed64,4,opt,name=z,proto3" json:"z,omitempty"`
+	Count         int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NadeSpot) Reset() {
+	*x = NadeSpot{}
+	mi := &file_match_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NadeSpot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NadeSpot) ProtoMessage() {}
+
+func (x *NadeSpot) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NadeSpot.ProtoReflect.Descriptor instead.
+func (*NadeSpot) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NadeSpot) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NadeSpot) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *NadeSpot) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *NadeSpot) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *NadeSpot) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type OpeningDuel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int64                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Winner        uint64                 `protobuf:"varint,2,opt,name=winner,proto3" json:"winner,omitempty"`
+	Loser         uint64                 `protobuf:"varint,3,opt,name=loser,proto3" json:"loser,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpeningDuel) Reset() {
+	*x = OpeningDuel{}
+	mi := &file_match_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpeningDuel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningDuel) ProtoMessage() {}
+
+func (x *OpeningDuel) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningDuel.ProtoReflect.Descriptor instead.
+func (*OpeningDuel) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OpeningDuel) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *OpeningDuel) GetWinner() uint64 {
+	if x != nil {
+		return x.Winner
+	}
+	return 0
+}
+
+func (x *OpeningDuel) GetLoser() uint64 {
+	if x != nil {
+		return x.Loser
+	}
+	return 0
+}
+
+type TeamTotals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamNum       int64                  `protobuf:"varint,1,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	Score         int64                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Won           bool                   `protobuf:"varint,3,opt,name=won,proto3" json:"won,omitempty"`
+	Players       int64                  `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`
+	Kills         int64                  `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths        int64                  `protobuf:"varint,6,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists       int64                  `protobuf:"varint,7,opt,name=assists,proto3" json:"assists,omitempty"`
+	Damage        int64                  `protobuf:"varint,8,opt,name=damage,proto3" json:"damage,omitempty"`
+	UtilityDamage int64                  `protobuf:"varint,9,opt,name=utility_damage,json=utilityDamage,proto3" json:"utility_damage,omitempty"`
+	Adr           float64                `protobuf:"fixed64,10,opt,name=adr,proto3" json:"adr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamTotals) Reset() {
+	*x = TeamTotals{}
+	mi := &file_match_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamTotals) ProtoMessage() {}
+
+func (x *TeamTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamTotals.ProtoReflect.Descriptor instead.
+func (*TeamTotals) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TeamTotals) GetTeamNum() int64 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetWon() bool {
+	if x != nil {
+		return x.Won
+	}
+	return false
+}
+
+func (x *TeamTotals) GetPlayers() int64 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetKills() int64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetDeaths() int64 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetAssists() int64 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetDamage() int64 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetUtilityDamage() int64 {
+	if x != nil {
+		return x.UtilityDamage
+	}
+	return 0
+}
+
+func (x *TeamTotals) GetAdr() float64 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+type BuyTypeWinRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuyType       string                 `protobuf:"bytes,2,opt,name=buy_type,json=buyType,proto3" json:"buy_type,omitempty"`
+	Rounds        int64                  `protobuf:"varint,3,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	WinRate       float64                `protobuf:"fixed64,4,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	TeamNum       int64                  `protobuf:"varint,5,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyTypeWinRate) Reset() {
+	*x = BuyTypeWinRate{}
+	mi := &file_match_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyTypeWinRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyTypeWinRate) ProtoMessage() {}
+
+func (x *BuyTypeWinRate) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyTypeWinRate.ProtoReflect.Descriptor instead.
+func (*BuyTypeWinRate) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BuyTypeWinRate) GetBuyType() string {
+	if x != nil {
+		return x.BuyType
+	}
+	return ""
+}
+
+func (x *BuyTypeWinRate) GetRounds() int64 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *BuyTypeWinRate) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *BuyTypeWinRate) GetTeamNum() int64 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+type PlantRounds struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Plants           int64                  `protobuf:"varint,1,opt,name=plants,proto3" json:"plants,omitempty"`
+	PostPlantWins    int64                  `protobuf:"varint,2,opt,name=post_plant_wins,json=postPlantWins,proto3" json:"post_plant_wins,omitempty"`
+	PostPlantWinRate float64                `protobuf:"fixed64,3,opt,name=post_plant_win_rate,json=postPlantWinRate,proto3" json:"post_plant_win_rate,omitempty"`
+	Retakes          int64                  `protobuf:"varint,4,opt,name=retakes,proto3" json:"retakes,omitempty"`
+	RetakeRate       float64                `protobuf:"fixed64,5,opt,name=retake_rate,json=retakeRate,proto3" json:"retake_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PlantRounds) Reset() {
+	*x = PlantRounds{}
+	mi := &file_match_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlantRounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlantRounds) ProtoMessage() {}
+
+func (x *PlantRounds) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlantRounds.ProtoReflect.Descriptor instead.
+func (*PlantRounds) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlantRounds) GetPlants() int64 {
+	if x != nil {
+		return x.Plants
+	}
+	return 0
+}
+
+func (x *PlantRounds) GetPostPlantWins() int64 {
+	if x != nil {
+		return x.PostPlantWins
+	}
+	return 0
+}
+
+func (x *PlantRounds) GetPostPlantWinRate() float64 {
+	if x != nil {
+		return x.PostPlantWinRate
+	}
+	return 0
+}
+
+func (x *PlantRounds) GetRetakes() int64 {
+	if x != nil {
+		return x.Retakes
+	}
+	return 0
+}
+
+func (x *PlantRounds) GetRetakeRate() float64 {
+	if x != nil {
+		return x.RetakeRate
+	}
+	return 0
+}
+
+type MatchResult struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SchemaVersion         int64                  `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	File                  string                 `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	ScoreStr              string                 `protobuf:"bytes,3,opt,name=score_str,json=scoreStr,proto3" json:"score_str,omitempty"`
+	Stats                 []*PlayerStats         `protobuf:"bytes,4,rep,name=stats,proto3" json:"stats,omitempty"`
+	MapName               string                 `protobuf:"bytes,5,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	ScoreT                int64                  `protobuf:"varint,6,opt,name=score_t,json=scoreT,proto3" json:"score_t,omitempty"`
+	ScoreCt               int64                  `protobuf:"varint,7,opt,name=score_ct,json=scoreCt,proto3" json:"score_ct,omitempty"`
+	EconomyTimeline       []*RoundEconomy        `protobuf:"bytes,8,rep,name=economy_timeline,json=economyTimeline,proto3" json:"economy_timeline,omitempty"`
+	AvgFirstContactTime   float64                `protobuf:"fixed64,9,opt,name=avg_first_contact_time,json=avgFirstContactTime,proto3" json:"avg_first_contact_time,omitempty"`
+	RoundTime             float64                `protobuf:"fixed64,10,opt,name=round_time,json=roundTime,proto3" json:"round_time,omitempty"`
+	FreezeTime            float64                `protobuf:"fixed64,11,opt,name=freeze_time,json=freezeTime,proto3" json:"freeze_time,omitempty"`
+	BombTime              float64                `protobuf:"fixed64,12,opt,name=bomb_time,json=bombTime,proto3" json:"bomb_time,omitempty"`
+	StatsFirstHalf        []*PlayerStats         `protobuf:"bytes,13,rep,name=stats_first_half,json=statsFirstHalf,proto3" json:"stats_first_half,omitempty"`
+	StatsSecondHalf       []*PlayerStats         `protobuf:"bytes,14,rep,name=stats_second_half,json=statsSecondHalf,proto3" json:"stats_second_half,omitempty"`
+	Killfeed              []*KillEvent           `protobuf:"bytes,15,rep,name=killfeed,proto3" json:"killfeed,omitempty"`
+	Rounds                []*RoundSummary        `protobuf:"bytes,16,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	LossBonus             []*LossBonusTotals     `protobuf:"bytes,17,rep,name=loss_bonus,json=lossBonus,proto3" json:"loss_bonus,omitempty"`
+	CommonNadeSpots       []*NadeSpot            `protobuf:"bytes,18,rep,name=common_nade_spots,json=commonNadeSpots,proto3" json:"common_nade_spots,omitempty"`
+	PlayerCount           int64                  `protobuf:"varint,19,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	Warnings              []string               `protobuf:"bytes,20,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Error                 string                 `protobuf:"bytes,21,opt,name=error,proto3" json:"error,omitempty"`
+	AvgTSideFirstContact  float64                `protobuf:"fixed64,22,opt,name=avg_t_side_first_contact,json=avgTSideFirstContact,proto3" json:"avg_t_side_first_contact,omitempty"`
+	AvgCtSideFirstContact float64                `protobuf:"fixed64,23,opt,name=avg_ct_side_first_contact,json=avgCtSideFirstContact,proto3" json:"avg_ct_side_first_contact,omitempty"`
+	OpeningDuels          []*OpeningDuel         `protobuf:"bytes,24,rep,name=opening_duels,json=openingDuels,proto3" json:"opening_duels,omitempty"`
+	Teams                 []*TeamTotals          `protobuf:"bytes,25,rep,name=teams,proto3" json:"teams,omitempty"`
+	BuyTypeWinRates       []*BuyTypeWinRate      `protobuf:"bytes,26,rep,name=buy_type_win_rates,json=buyTypeWinRates,proto3" json:"buy_type_win_rates,omitempty"`
+	PlantRounds           *PlantRounds           `protobuf:"bytes,27,opt,name=plant_rounds,json=plantRounds,proto3" json:"plant_rounds,omitempty"`
+	Skipped               bool                   `protobuf:"varint,28,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	DemoHash              string                 `protobuf:"bytes,29,opt,name=demo_hash,json=demoHash,proto3" json:"demo_hash,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MatchResult) Reset() {
+	*x = MatchResult{}
+	mi := &file_match_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResult) ProtoMessage() {}
+
+func (x *MatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_match_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
+func (*MatchResult) Descriptor() ([]byte, []int) {
+	return file_match_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MatchResult) GetSchemaVersion() int64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *MatchResult) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *MatchResult) GetScoreStr() string {
+	if x != nil {
+		return x.ScoreStr
+	}
+	return ""
+}
+
+func (x *MatchResult) GetStats() []*PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *MatchResult) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *MatchResult) GetScoreT() int64 {
+	if x != nil {
+		return x.ScoreT
+	}
+	return 0
+}
+
+func (x *MatchResult) GetScoreCt() int64 {
+	if x != nil {
+		return x.ScoreCt
+	}
+	return 0
+}
+
+func (x *MatchResult) GetEconomyTimeline() []*RoundEconomy {
+	if x != nil {
+		return x.EconomyTimeline
+	}
+	return nil
+}
+
+func (x *MatchResult) GetAvgFirstContactTime() float64 {
+	if x != nil {
+		return x.AvgFirstContactTime
+	}
+	return 0
+}
+
+func (x *MatchResult) GetRoundTime() float64 {
+	if x != nil {
+		return x.RoundTime
+	}
+	return 0
+}
+
+func (x *MatchResult) GetFreezeTime() float64 {
+	if x != nil {
+		return x.FreezeTime
+	}
+	return 0
+}
+
+func (x *MatchResult) GetBombTime() float64 {
+	if x != nil {
+		return x.BombTime
+	}
+	return 0
+}
+
+func (x *MatchResult) GetStatsFirstHalf() []*PlayerStats {
+	if x != nil {
+		return x.StatsFirstHalf
+	}
+	return nil
+}
+
+func (x *MatchResult) GetStatsSecondHalf() []*PlayerStats {
+	if x != nil {
+		return x.StatsSecondHalf
+	}
+	return nil
+}
+
+func (x *MatchResult) GetKillfeed() []*KillEvent {
+	if x != nil {
+		return x.Killfeed
+	}
+	return nil
+}
+
+func (x *MatchResult) GetRounds() []*RoundSummary {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *MatchResult) GetLossBonus() []*LossBonusTotals {
+	if x != nil {
+		return x.LossBonus
+	}
+	return nil
+}
+
+func (x *MatchResult) GetCommonNadeSpots() []*NadeSpot {
+	if x != nil {
+		return x.CommonNadeSpots
+	}
+	return nil
+}
+
+func (x *MatchResult) GetPlayerCount() int64 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *MatchResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *MatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MatchResult) GetAvgTSideFirstContact() float64 {
+	if x != nil {
+		return x.AvgTSideFirstContact
+	}
+	return 0
+}
+
+func (x *MatchResult) GetAvgCtSideFirstContact() float64 {
+	if x != nil {
+		return x.AvgCtSideFirstContact
+	}
+	return 0
+}
+
+func (x *MatchResult) GetOpeningDuels() []*OpeningDuel {
+	if x != nil {
+		return x.OpeningDuels
+	}
+	return nil
+}
+
+func (x *MatchResult) GetTeams() []*TeamTotals {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *MatchResult) GetBuyTypeWinRates() []*BuyTypeWinRate {
+	if x != nil {
+		return x.BuyTypeWinRates
+	}
+	return nil
+}
+
+func (x *MatchResult) GetPlantRounds() *PlantRounds {
+	if x != nil {
+		return x.PlantRounds
+	}
+	return nil
+}
+
+func (x *MatchResult) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *MatchResult) GetDemoHash() string {
+	if x != nil {
+		return x.DemoHash
+	}
+	return ""
+}
+
+var File_match_proto protoreflect.FileDescriptor
+
+var file_match_proto_rawDesc = string([]byte{
+	0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x75,
+	0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x22, 0x3c, 0x0a, 0x0e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0a, 0x57, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x46, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x48, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x73, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x22, 0xf9, 0x1f, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6b, 0x69, 0x6c,
+	0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x02, 0x6b, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x61, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x73, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x5f, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x68, 0x65, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x65, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x6e, 0x6f, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x6e, 0x6f, 0x54, 0x69, 0x63, 0x6b, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x66, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x65, 0x61, 0x6d, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x67, 0x72, 0x65,
+	0x6e, 0x61, 0x64, 0x65, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x77, 0x6e, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x6e,
+	0x61, 0x64, 0x65, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x67, 0x72, 0x65, 0x6e, 0x61, 0x64, 0x65, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x6e, 0x12,
+	0x2e, 0x0a, 0x13, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x74,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x75, 0x74, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x6d, 0x6f, 0x6b, 0x65, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x77, 0x6e, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x6d, 0x6f, 0x6b, 0x65, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x6c, 0x6f, 0x74, 0x6f, 0x76, 0x73, 0x5f, 0x74, 0x68, 0x72,
+	0x6f, 0x77, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x6f, 0x6c, 0x6f, 0x74,
+	0x6f, 0x76, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x66, 0x69, 0x72,
+	0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x66, 0x69, 0x72, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x66,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x65,
+	0x61, 0x6d, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6c, 0x61,
+	0x73, 0x68, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x19, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x6e, 0x65, 0x6d,
+	0x79, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x73,
+	0x68, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x76, 0x67, 0x45, 0x6e, 0x65, 0x6d,
+	0x79, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72,
+	0x18, 0x24, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x44, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x31, 0x30, 0x30, 0x30, 0x18, 0x25, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x31, 0x30, 0x30, 0x30, 0x12, 0x26, 0x0a, 0x0f,
+	0x61, 0x76, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18,
+	0x26, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x61, 0x76, 0x67, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6b, 0x69,
+	0x6c, 0x6c, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64,
+	0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x29, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x44, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12,
+	0x2b, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x69, 0x6d,
+	0x65, 0x54, 0x6f, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x2c, 0x0a, 0x12,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x64, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x54, 0x12, 0x2d, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x30, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x5f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x61, 0x76, 0x65, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x61,
+	0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x5f, 0x77, 0x69,
+	0x6e, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x57, 0x69, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6b, 0x69,
+	0x6c, 0x6c, 0x73, 0x18, 0x34, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x61, 0x73, 0x74, 0x18, 0x35, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x6b, 0x61, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x36, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x69, 0x6c,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x69,
+	0x6c, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x6b, 0x69, 0x6c,
+	0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x37, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x38, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x77,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x39, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x70, 0x6f,
+	0x6e, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x77, 0x65, 0x61,
+	0x70, 0x6f, 0x6e, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x3a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x62,
+	0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x3b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x42,
+	0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x42, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x7a, 0x65, 0x75, 0x73, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x3c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x7a, 0x65, 0x75, 0x73, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6b, 0x69, 0x6c,
+	0x6c, 0x73, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x75, 0x6d,
+	0x70, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6a,
+	0x75, 0x6d, 0x70, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x65,
+	0x61, 0x6d, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x6b,
+	0x69, 0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x40, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x4b, 0x69, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x4b, 0x69, 0x6c, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6d, 0x62, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x42,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6f, 0x6d, 0x62, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x6d, 0x62, 0x5f, 0x64, 0x65, 0x66, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x43, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6f, 0x6d, 0x62, 0x44, 0x65, 0x66, 0x75,
+	0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x6d, 0x62, 0x5f, 0x70, 0x69, 0x63, 0x6b,
+	0x75, 0x70, 0x73, 0x18, 0x44, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6f, 0x6d, 0x62, 0x50,
+	0x69, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6d, 0x62, 0x5f, 0x64,
+	0x72, 0x6f, 0x70, 0x73, 0x18, 0x45, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6f, 0x6d, 0x62,
+	0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x46, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x74, 0x65, 0x64, 0x18,
+	0x47, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x53, 0x70,
+	0x6f, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x18, 0x48, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x49,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x64, 0x72, 0x18, 0x4a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x64, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6d, 0x6f, 0x6b, 0x65, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x4b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6d, 0x6f, 0x6b, 0x65, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x5f, 0x77, 0x61, 0x79, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x18, 0x4c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x4b, 0x69,
+	0x6c, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x5f, 0x6c,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18,
+	0x4d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x4c, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x6d, 0x6f, 0x6b, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x4e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x6d, 0x6f, 0x6b, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x4f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x41,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x6c, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x50, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x73, 0x74, 0x54, 0x6f,
+	0x44, 0x65, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x63, 0x6f, 0x5f, 0x6b, 0x69, 0x6c,
+	0x6c, 0x73, 0x18, 0x51, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x63, 0x6f, 0x4b, 0x69, 0x6c,
+	0x6c, 0x73, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x6f, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x52, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x75, 0x6e, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x5f, 0x76, 0x5f, 0x6f, 0x6e, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x73, 0x18, 0x53, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x56,
+	0x4f, 0x6e, 0x65, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6f, 0x6e, 0x65, 0x5f, 0x76,
+	0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x54, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x56, 0x4f, 0x6e, 0x65, 0x4c, 0x6f, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x70, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x18, 0x55, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x48, 0x70, 0x4b, 0x69, 0x6c,
+	0x6c, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x77, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x56, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6b, 0x69,
+	0x6c, 0x6c, 0x73, 0x57, 0x68, 0x65, 0x6e, 0x41, 0x68, 0x65, 0x61, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x62, 0x65, 0x68, 0x69, 0x6e,
+	0x64, 0x18, 0x57, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x57, 0x68,
+	0x65, 0x6e, 0x42, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x5f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x18, 0x58, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x57, 0x68, 0x65, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x12, 0x23, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x68, 0x70, 0x5f, 0x61, 0x74, 0x5f, 0x6b, 0x69,
+	0x6c, 0x6c, 0x18, 0x59, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x76, 0x67, 0x48, 0x70, 0x41,
+	0x74, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61,
+	0x76, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x5b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x76, 0x67, 0x5f, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x74,
+	0x68, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x61, 0x76, 0x67, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x63, 0x65, 0x73, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6d, 0x62, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18,
+	0x5e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6f, 0x6d, 0x62, 0x4b, 0x69, 0x6c, 0x6c, 0x73,
+	0x1a, 0x41, 0x0a, 0x13, 0x47, 0x72, 0x65, 0x6e, 0x61, 0x64, 0x65, 0x73, 0x54, 0x68, 0x72, 0x6f,
+	0x77, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x69, 0x6c, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4b, 0x69, 0x6c, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x56, 0x0a, 0x10, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x64, 0x2e, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x4b, 0x69,
+	0x6c, 0x6c, 0x73, 0x42, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f,
+	0x0a, 0x11, 0x43, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xb2, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x63, 0x6f, 0x6e, 0x6f, 0x6d, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x5f, 0x65, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x74, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x63, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x74, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x74, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x22, 0xf5, 0x02, 0x0a, 0x09, 0x4b, 0x69, 0x6c, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6b, 0x69,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x73,
+	0x6d, 0x6f, 0x6b, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x6f,
+	0x75, 0x67, 0x68, 0x53, 0x6d, 0x6f, 0x6b, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x61, 0x73,
+	0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x6d, 0x0a, 0x0d,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75, 0x72, 0x76, 0x69, 0x76, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x68,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x68, 0x70, 0x22, 0xcd, 0x01, 0x0a, 0x09,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x6f, 0x6d, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x75, 0x73, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x64,
+	0x65, 0x66, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x65, 0x66, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x64, 0x65, 0x66, 0x75, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x64, 0x65, 0x66, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x65, 0x66, 0x75, 0x73, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x22, 0x9d, 0x02, 0x0a, 0x0c,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x75,
+	0x72, 0x76, 0x69, 0x76, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x53, 0x75, 0x72, 0x76, 0x69, 0x76, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76,
+	0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6d, 0x76, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x76, 0x70,
+	0x12, 0x22, 0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x74,
+	0x4c, 0x6f, 0x73, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x62, 0x6f, 0x6d, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x42, 0x6f, 0x6d, 0x62, 0x52, 0x04, 0x62, 0x6f, 0x6d, 0x62, 0x22, 0xa2, 0x01, 0x0a, 0x0f,
+	0x4c, 0x6f, 0x73, 0x73, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f,
+	0x6e, 0x75, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c,
+	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x22, 0x5e, 0x0a, 0x08, 0x4e, 0x61, 0x64, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01,
+	0x7a, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x51, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x65, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x6f,
+	0x73, 0x65, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x0a, 0x54, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x77, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x64, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x64, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x42, 0x75, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x75, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x75, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61,
+	0x6d, 0x4e, 0x75, 0x6d, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65,
+	0x22, 0xb7, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x73,
+	0x12, 0x2d, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x5f, 0x77,
+	0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x70,
+	0x6f, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x72, 0x65, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74,
+	0x61, 0x6b, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x94, 0x0a, 0x0a, 0x0b, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x73,
+	0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x53,
+	0x74, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x54, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x74,
+	0x12, 0x43, 0x0a, 0x10, 0x65, 0x63, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x63, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x79, 0x52, 0x0f, 0x65, 0x63, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x76, 0x67, 0x5f, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x61, 0x76, 0x67, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f,
+	0x6d, 0x62, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62,
+	0x6f, 0x6d, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x66, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x48, 0x61, 0x6c, 0x66, 0x12, 0x43, 0x0a, 0x11, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x68, 0x61, 0x6c, 0x66, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x64, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x48, 0x61, 0x6c, 0x66, 0x12,
+	0x31, 0x0a, 0x08, 0x6b, 0x69, 0x6c, 0x6c, 0x66, 0x65, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x4b,
+	0x69, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6b, 0x69, 0x6c, 0x6c, 0x66, 0x65,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x62, 0x6f, 0x6e,
+	0x75, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x4c, 0x6f, 0x73, 0x73, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x09, 0x6c, 0x6f, 0x73, 0x73, 0x42, 0x6f, 0x6e, 0x75, 0x73,
+	0x12, 0x40, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x64, 0x65, 0x5f,
+	0x73, 0x70, 0x6f, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75, 0x6e,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x4e, 0x61, 0x64, 0x65, 0x53, 0x70, 0x6f,
+	0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x64, 0x65, 0x53, 0x70, 0x6f,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x18, 0x61, 0x76, 0x67, 0x5f, 0x74,
+	0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x61, 0x76, 0x67, 0x54, 0x53,
+	0x69, 0x64, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
+	0x38, 0x0a, 0x19, 0x61, 0x76, 0x67, 0x5f, 0x63, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x15, 0x61, 0x76, 0x67, 0x43, 0x74, 0x53, 0x69, 0x64, 0x65, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x75, 0x65, 0x6c, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x65, 0x6c, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x44, 0x75, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x12, 0x62, 0x75, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x2e, 0x42,
+	0x75, 0x79, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x62,
+	0x75, 0x79, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3a,
+	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x64, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x0b, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x6d, 0x6f, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6d, 0x6f, 0x48, 0x61, 0x73,
+	0x68, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x6f, 0x5f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_match_proto_rawDescOnce sync.Once
+	file_match_proto_rawDescData []byte
+)
+
+func file_match_proto_rawDescGZIP() []byte {
+	file_match_proto_rawDescOnce.Do(func() {
+		file_match_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_match_proto_rawDesc), len(file_match_proto_rawDesc)))
+	})
+	return file_match_proto_rawDescData
+}
+
+var file_match_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_match_proto_goTypes = []any{
+	(*MultiKillRound)(nil),  // 0: unbalanced.MultiKillRound
+	(*WeaponStat)(nil),      // 1: unbalanced.WeaponStat
+	(*PlayerStats)(nil),     // 2: unbalanced.PlayerStats
+	(*RoundEconomy)(nil),    // 3: unbalanced.RoundEconomy
+	(*KillEvent)(nil),       // 4: unbalanced.KillEvent
+	(*RoundSurvivor)(nil),   // 5: unbalanced.RoundSurvivor
+	(*RoundBomb)(nil),       // 6: unbalanced.RoundBomb
+	(*RoundSummary)(nil),    // 7: unbalanced.RoundSummary
+	(*LossBonusTotals)(nil), // 8: unbalanced.LossBonusTotals
+	(*NadeSpot)(nil),        // 9: unbalanced.NadeSpot
+	(*OpeningDuel)(nil),     // 10: unbalanced.OpeningDuel
+	(*TeamTotals)(nil),      // 11: unbalanced.TeamTotals
+	(*BuyTypeWinRate)(nil),  // 12: unbalanced.BuyTypeWinRate
+	(*PlantRounds)(nil),     // 13: unbalanced.PlantRounds
+	(*MatchResult)(nil),     // 14: unbalanced.MatchResult
+	nil,                     // 15: unbalanced.PlayerStats.GrenadesThrownEntry
+	nil,                     // 16: unbalanced.PlayerStats.MultiKillsEntry
+	nil,                     // 17: unbalanced.PlayerStats.WeaponKillsEntry
+	nil,                     // 18: unbalanced.PlayerStats.WeaponStatsEntry
+	nil,                     // 19: unbalanced.PlayerStats.KillsByCategoryEntry
+	nil,                     // 20: unbalanced.PlayerStats.ClutchLossesEntry
+}
+var file_match_proto_depIdxs = []int32{
+	15, // 0: unbalanced.PlayerStats.grenades_thrown:type_name -> unbalanced.PlayerStats.GrenadesThrownEntry
+	16, // 1: unbalanced.PlayerStats.multi_kills:type_name -> unbalanced.PlayerStats.MultiKillsEntry
+	0,  // 2: unbalanced.PlayerStats.multi_kill_rounds:type_name -> unbalanced.MultiKillRound
+	17, // 3: unbalanced.PlayerStats.weapon_kills:type_name -> unbalanced.PlayerStats.WeaponKillsEntry
+	18, // 4: unbalanced.PlayerStats.weapon_stats:type_name -> unbalanced.PlayerStats.WeaponStatsEntry
+	19, // 5: unbalanced.PlayerStats.kills_by_category:type_name -> unbalanced.PlayerStats.KillsByCategoryEntry
+	20, // 6: unbalanced.PlayerStats.clutch_losses:type_name -> unbalanced.PlayerStats.ClutchLossesEntry
+	5,  // 7: unbalanced.RoundSummary.survivors:type_name -> unbalanced.RoundSurvivor
+	6,  // 8: unbalanced.RoundSummary.bomb:type_name -> unbalanced.RoundBomb
+	2,  // 9: unbalanced.MatchResult.stats:type_name -> unbalanced.PlayerStats
+	3,  // 10: unbalanced.MatchResult.economy_timeline:type_name -> unbalanced.RoundEconomy
+	2,  // 11: unbalanced.MatchResult.stats_first_half:type_name -> unbalanced.PlayerStats
+	2,  // 12: unbalanced.MatchResult.stats_second_half:type_name -> unbalanced.PlayerStats
+	4,  // 13: unbalanced.MatchResult.killfeed:type_name -> unbalanced.KillEvent
+	7,  // 14: unbalanced.MatchResult.rounds:type_name -> unbalanced.RoundSummary
+	8,  // 15: unbalanced.MatchResult.loss_bonus:type_name -> unbalanced.LossBonusTotals
+	9,  // 16: unbalanced.MatchResult.common_nade_spots:type_name -> unbalanced.NadeSpot
+	10, // 17: unbalanced.MatchResult.opening_duels:type_name -> unbalanced.OpeningDuel
+	11, // 18: unbalanced.MatchResult.teams:type_name -> unbalanced.TeamTotals
+	12, // 19: unbalanced.MatchResult.buy_type_win_rates:type_name -> unbalanced.BuyTypeWinRate
+	13, // 20: unbalanced.MatchResult.plant_rounds:type_name -> unbalanced.PlantRounds
+	1,  // 21: unbalanced.PlayerStats.WeaponStatsEntry.value:type_name -> unbalanced.WeaponStat
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_match_proto_init() }
+func file_match_proto_init() {
+	if File_match_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_match_proto_rawDesc), len(file_match_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_match_proto_goTypes,
+		DependencyIndexes: file_match_proto_depIdxs,
+		MessageInfos:      file_match_proto_msgTypes,
+	}.Build()
+	File_match_proto = out.File
+	file_match_proto_goTypes = nil
+	file_match_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go_parser/demostats"
+	"go_parser/matchpb"
+)
+
+// fillAll sets every exported output field of v to a non-zero value
+func fillAll(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillAll(v.Elem())
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillAll(v.Index(0))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		val := reflect.New(v.Type().Elem()).Elem()
+		fillAll(key)
+		fillAll(val)
+		v.SetMapIndex(key, val)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() && t.Field(i).Tag.Get("json") != "-" {
+				fillAll(v.Field(i))
+			}
+		}
+	}
+}
+
+// checkAllSet reports every field of m, and of the messages inside it,
+// that nothing was written to
+func checkAllSet(t *testing.T, m protoreflect.Message) {
+	t.Helper()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			t.Errorf("%s is never written", fd.FullName())
+			continue
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			checkAllSet(t, m.Get(fd).List().Get(0).Message())
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			m.Get(fd).Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				checkAllSet(t, v.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			checkAllSet(t, m.Get(fd).Message())
+		}
+	}
+}
+
+// Every output field must have a counterpart in match.proto of the same
+// type, and match.proto must not have fields the output lacks.
+func TestProtoCoversOutput(t *testing.T) {
+	var result demostats.MatchResult
+	fillAll(reflect.ValueOf(&result).Elem())
+
+	var check func(typ reflect.Type, md protoreflect.MessageDescriptor)
+	check = func(typ reflect.Type, md protoreflect.MessageDescriptor) {
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() || field.Tag.Get("json") == "-" {
+				continue
+			}
+			fd := md.Fields().ByName(protoreflect.Name(snakeCase(field.Name)))
+			if fd == nil {
+				t.Errorf("%s.%s has no field in match.proto", typ.Name(), field.Name)
+				continue
+			}
+			ft := field.Type
+			switch {
+			case fd.IsMap():
+				ft = ft.Elem()
+			case fd.IsList():
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				sub := fd.Message()
+				if fd.IsMap() {
+					sub = fd.MapValue().Message()
+				}
+				check(ft, sub)
+			}
+		}
+	}
+	check(reflect.TypeOf(result), (&matchpb.MatchResult{}).ProtoReflect().Descriptor())
+
+	b, err := protoMarshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg matchpb.MatchResult
+	if err := proto.Unmarshal(b, &msg); err != nil {
+		t.Fatal(err)
+	}
+	checkAllSet(t, msg.ProtoReflect())
+	if got := msg.GetStats()[0].GetWeaponStats()["x"].GetShotsHit(); got != 1 {
+		t.Errorf("Stats[0].WeaponStats[x].ShotsHit = %d, want 1", got)
+	}
+}
+
+// The same result must always encode to the same bytes, maps included
+func TestProtoMarshalIsDeterministic(t *testing.T) {
+	s := demostats.PlayerStats{WeaponKills: map[string]int{}, MultiKills: map[int]int{}}
+	for i, name := range demostats.CanonicalWeapons {
+		s.WeaponKills[name] = i
+		s.MultiKills[i] = i
+	}
+	result := demostats.MatchResult{Stats: []demostats.PlayerStats{s}}
+	first, err := protoMarshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		b, err := protoMarshal(result)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != string(first) {
+			t.Fatal("protoMarshal gave different bytes for the same result")
+		}
+	}
+}
+
+func TestProtoMarshalInvalidUTF8(t *testing.T) {
+	b, err := protoMarshal(demostats.MatchResult{Stats: []demostats.PlayerStats{{Player: "bad\xffname"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg matchpb.MatchResult
+	if err := proto.Unmarshal(b, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.GetStats()[0].GetPlayer(); got != "bad�name" {
+		t.Errorf("Player = %q, want %q", got, "bad�name")
+	}
+}